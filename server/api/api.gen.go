@@ -0,0 +1,1840 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.2 DO NOT EDIT.
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for AvailabilitySlotPreference.
+const (
+	Available AvailabilitySlotPreference = "available"
+	IfNeeded  AvailabilitySlotPreference = "if_needed"
+	Preferred AvailabilitySlotPreference = "preferred"
+)
+
+// Valid indicates whether the value is a known member of the AvailabilitySlotPreference enum.
+func (e AvailabilitySlotPreference) Valid() bool {
+	switch e {
+	case Available:
+		return true
+	case IfNeeded:
+		return true
+	case Preferred:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for EventStatus.
+const (
+	Closed EventStatus = "closed"
+	Open   EventStatus = "open"
+)
+
+// Valid indicates whether the value is a known member of the EventStatus enum.
+func (e EventStatus) Valid() bool {
+	switch e {
+	case Closed:
+		return true
+	case Open:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SeriesOptionsIntervalWeeks.
+const (
+	N1 SeriesOptionsIntervalWeeks = 1
+	N2 SeriesOptionsIntervalWeeks = 2
+)
+
+// Valid indicates whether the value is a known member of the SeriesOptionsIntervalWeeks enum.
+func (e SeriesOptionsIntervalWeeks) Valid() bool {
+	switch e {
+	case N1:
+		return true
+	case N2:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetAPIDocsAssetParamsFile.
+const (
+	Favicon16x16Png   GetAPIDocsAssetParamsFile = "favicon-16x16.png"
+	Favicon32x32Png   GetAPIDocsAssetParamsFile = "favicon-32x32.png"
+	SwaggerUiBundleJs GetAPIDocsAssetParamsFile = "swagger-ui-bundle.js"
+	SwaggerUiCss      GetAPIDocsAssetParamsFile = "swagger-ui.css"
+)
+
+// Valid indicates whether the value is a known member of the GetAPIDocsAssetParamsFile enum.
+func (e GetAPIDocsAssetParamsFile) Valid() bool {
+	switch e {
+	case Favicon16x16Png:
+		return true
+	case Favicon32x32Png:
+		return true
+	case SwaggerUiBundleJs:
+		return true
+	case SwaggerUiCss:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExportEventParamsFormat.
+const (
+	Csv  ExportEventParamsFormat = "csv"
+	Json ExportEventParamsFormat = "json"
+)
+
+// Valid indicates whether the value is a known member of the ExportEventParamsFormat enum.
+func (e ExportEventParamsFormat) Valid() bool {
+	switch e {
+	case Csv:
+		return true
+	case Json:
+		return true
+	default:
+		return false
+	}
+}
+
+// AvailabilityImport defines model for AvailabilityImport.
+type AvailabilityImport struct {
+	Message string `json:"message"`
+
+	// Normalized How submitted availability was changed before it was stored. One-off slots with the same preference are merged when they overlap or touch, clipped to the event's slots and sorted.
+	Normalized AvailabilityNormalization `json:"normalized"`
+
+	// Participants Participants whose availability was replaced
+	Participants int `json:"participants"`
+
+	// Slots Slots stored after normalization
+	Slots int `json:"slots"`
+}
+
+// AvailabilityNormalization How submitted availability was changed before it was stored. One-off slots with the same preference are merged when they overlap or touch, clipped to the event's slots and sorted.
+type AvailabilityNormalization struct {
+	// Clipped Slots trimmed or split to fit the event's slots
+	Clipped int `json:"clipped"`
+
+	// Dropped Slots entirely outside the event's slots
+	Dropped int `json:"dropped"`
+
+	// Merged Slots folded into an overlapping or adjacent slot
+	Merged int `json:"merged"`
+}
+
+// AvailabilityResponse The event's slots ranked by how many participants can attend
+type AvailabilityResponse struct {
+	RecommendedTimeSlots []SlotRecommendation `json:"recommendedTimeSlots"`
+
+	// SeriesRecommendations For series events, every candidate scored across its occurrences, best first
+	SeriesRecommendations []SeriesRecommendation `json:"seriesRecommendations,omitempty"`
+}
+
+// AvailabilitySlot defines model for AvailabilitySlot.
+type AvailabilitySlot struct {
+	EndTime    time.Time                  `json:"end_time"`
+	Exdates    []time.Time                `json:"exdates,omitempty"`
+	Preference AvailabilitySlotPreference `json:"preference,omitempty"`
+	Rrule      string                     `json:"rrule,omitempty"`
+
+	// SlotIds IDs of the event slots this availability overlaps
+	SlotIds   []string  `json:"slot_ids,omitempty"`
+	StartTime time.Time `json:"start_time"`
+	Zone      string    `json:"zone,omitempty"`
+}
+
+// AvailabilitySlotPreference defines model for AvailabilitySlot.Preference.
+type AvailabilitySlotPreference string
+
+// AvailabilityUpdate defines model for AvailabilityUpdate.
+type AvailabilityUpdate struct {
+	// Availability The stored slots after normalization
+	Availability []AvailabilitySlot `json:"availability,omitempty"`
+	Message      string             `json:"message,omitempty"`
+
+	// Normalized How submitted availability was changed before it was stored. One-off slots with the same preference are merged when they overlap or touch, clipped to the event's slots and sorted.
+	Normalized AvailabilityNormalization `json:"normalized,omitempty"`
+}
+
+// Event An event and the candidate slots its participants give their availability for. The id, status, finalized_slot, at_risk and conflicts fields are managed by the server and ignored in requests.
+type Event struct {
+	// AtRisk The finalized slot no longer works for every participant
+	AtRisk bool `json:"at_risk,omitempty"`
+
+	// AutoFinalize Finalize the top recommended slot when the deadline passes
+	AutoFinalize bool `json:"auto_finalize,omitempty"`
+
+	// Conflicts Participants who can no longer attend the finalized slot
+	Conflicts []string `json:"conflicts,omitempty"`
+
+	// EstimatedTime Meeting length in nanoseconds
+	EstimatedTime int64 `json:"estimatedTime"`
+
+	// FinalizedSlot A candidate slot. The server assigns each slot an ID that stays the same while the slot is kept, including across PUT and PATCH when the slot is sent back with its ID, and is never reused for the event.
+	FinalizedSlot *Slot `json:"finalized_slot,omitempty"`
+
+	// Horizon Expand recurring slots up to this time (defaults to 90 days after the first slot); must be after the first slot starts and at most two years later
+	Horizon *time.Time `json:"horizon,omitempty"`
+	Id      string     `json:"id,omitempty"`
+
+	// Organizer Organizer whose CalDAV collection lists this event
+	Organizer    string   `json:"organizer,omitempty"`
+	Participants []string `json:"participants,omitempty"`
+
+	// ResponseDeadline Availability responses are rejected after this time
+	ResponseDeadline *time.Time `json:"response_deadline,omitempty"`
+
+	// Series Schedule a recurring meeting; each slot is scored across the whole series
+	Series *SeriesOptions `json:"series,omitempty"`
+	Slots  []Slot         `json:"slots"`
+	Status EventStatus    `json:"status,omitempty"`
+	Title  string         `json:"title"`
+}
+
+// EventStatus defines model for Event.Status.
+type EventStatus string
+
+// EventExport defines model for EventExport.
+type EventExport struct {
+	// Event An event and the candidate slots its participants give their availability for. The id, status, finalized_slot, at_risk and conflicts fields are managed by the server and ignored in requests.
+	Event                Event                `json:"event"`
+	Participants         []Participant        `json:"participants"`
+	RecommendedTimeSlots []SlotRecommendation `json:"recommendedTimeSlots"`
+
+	// SeriesRecommendations For series events, every candidate scored across its occurrences, best first
+	SeriesRecommendations []SeriesRecommendation `json:"seriesRecommendations,omitempty"`
+}
+
+// FieldError Points at the input that caused a problem
+type FieldError struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+
+	// Row Row of an uploaded file, counting the header as row 1
+	Row int `json:"row,omitempty"`
+}
+
+// Heatmap defines model for Heatmap.
+type Heatmap struct {
+	Buckets      []HeatmapBucket `json:"buckets"`
+	Interval     string          `json:"interval"`
+	Participants []string        `json:"participants"`
+}
+
+// HeatmapBucket One cell of the availability grid
+type HeatmapBucket struct {
+	AvailableParticipants []string  `json:"availableParticipants"`
+	Count                 int       `json:"count"`
+	EndTime               time.Time `json:"end_time"`
+	StartTime             time.Time `json:"start_time"`
+}
+
+// Message defines model for Message.
+type Message struct {
+	Message string `json:"message"`
+}
+
+// Participant A participant's availability for an event
+type Participant struct {
+	Availability []AvailabilitySlot `json:"availability"`
+	EventId      string             `json:"event_id"`
+	Id           string             `json:"id"`
+}
+
+// Problem RFC 7807 problem details returned for every error
+type Problem struct {
+	// Detail Explanation specific to this occurrence
+	Detail string `json:"detail,omitempty"`
+
+	// Errors The fields, or rows of an uploaded file, that were rejected
+	Errors []FieldError `json:"errors,omitempty"`
+	Status int          `json:"status"`
+
+	// Title Short summary that is the same for every problem of this type
+	Title string `json:"title"`
+
+	// Type Identifies the kind of problem
+	Type string `json:"type"`
+}
+
+// SeriesOptions Schedule a recurring meeting; each slot is scored across the whole series
+type SeriesOptions struct {
+	IntervalWeeks SeriesOptionsIntervalWeeks `json:"interval_weeks,omitempty"`
+	Occurrences   int                        `json:"occurrences"`
+
+	// Zone Time zone whose wall clock time the meeting keeps across DST changes
+	Zone string `json:"zone,omitempty"`
+}
+
+// SeriesOptionsIntervalWeeks defines model for SeriesOptions.IntervalWeeks.
+type SeriesOptionsIntervalWeeks int
+
+// SeriesRecommendation defines model for SeriesRecommendation.
+type SeriesRecommendation struct {
+	// Attendance Occurrences each participant can attend
+	Attendance  map[string]int       `json:"attendance"`
+	Occurrences []SlotRecommendation `json:"occurrences"`
+
+	// Score Total occurrences attendable, summed over participants
+	Score int `json:"score"`
+
+	// Slot A candidate slot. The server assigns each slot an ID that stays the same while the slot is kept, including across PUT and PATCH when the slot is sent back with its ID, and is never reused for the event.
+	Slot Slot `json:"slot"`
+}
+
+// Slot A candidate slot. The server assigns each slot an ID that stays the same while the slot is kept, including across PUT and PATCH when the slot is sent back with its ID, and is never reused for the event.
+type Slot struct {
+	EndTime   time.Time   `json:"end_time"`
+	Exdates   []time.Time `json:"exdates,omitempty"`
+	Id        string      `json:"id,omitempty"`
+	Rrule     string      `json:"rrule,omitempty"`
+	StartTime time.Time   `json:"start_time"`
+	Zone      string      `json:"zone,omitempty"`
+}
+
+// SlotRecommendation defines model for SlotRecommendation.
+type SlotRecommendation struct {
+	// Slot A candidate slot. The server assigns each slot an ID that stays the same while the slot is kept, including across PUT and PATCH when the slot is sent back with its ID, and is never reused for the event.
+	Slot Slot `json:"slot"`
+
+	// UnavailableParticipants Participants who are not free for a continuous part of the slot as long as the meeting
+	UnavailableParticipants []string `json:"unavailableParticipants"`
+}
+
+// GetAPIDocsAssetParamsFile defines parameters for GetAPIDocsAsset.
+type GetAPIDocsAssetParamsFile string
+
+// GetAvailabilityPageParams defines parameters for GetAvailabilityPage.
+type GetAvailabilityPageParams struct {
+	ParticipantId string `form:"participant_id,omitempty" json:"participant_id,omitempty"`
+	Zone          string `form:"zone,omitempty" json:"zone,omitempty"`
+
+	// Saved Set when the form redirects back after saving, to confirm the save
+	Saved string `form:"saved,omitempty" json:"saved,omitempty"`
+}
+
+// SubmitAvailabilityPageFormdataBody defines parameters for SubmitAvailabilityPage.
+type SubmitAvailabilityPageFormdataBody struct {
+	ParticipantId string   `form:"participant_id,omitempty" json:"participant_id,omitempty"`
+	Slot          []string `form:"slot,omitempty" json:"slot,omitempty"`
+	Zone          string   `form:"zone,omitempty" json:"zone,omitempty"`
+}
+
+// ExecuteGraphQLJSONBody defines parameters for ExecuteGraphQL.
+type ExecuteGraphQLJSONBody struct {
+	OperationName string                 `json:"operationName,omitempty"`
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// CreateParticipantAvailabilityJSONBody defines parameters for CreateParticipantAvailability.
+type CreateParticipantAvailabilityJSONBody struct {
+	EventId       string             `json:"event_id,omitempty"`
+	ParticipantId string             `json:"participant_id,omitempty"`
+	Slots         []AvailabilitySlot `json:"slots,omitempty"`
+}
+
+// CreateParticipantAvailabilityMultipartBody defines parameters for CreateParticipantAvailability.
+type CreateParticipantAvailabilityMultipartBody struct {
+	Calendar      openapi_types.File `json:"calendar,omitempty"`
+	EventId       string             `json:"event_id,omitempty"`
+	ParticipantId string             `json:"participant_id,omitempty"`
+	Zone          string             `json:"zone,omitempty"`
+}
+
+// CreateParticipantAvailabilityParams defines parameters for CreateParticipantAvailability.
+type CreateParticipantAvailabilityParams struct {
+	// ParticipantId Participant ID for text/calendar uploads
+	ParticipantId string `form:"participant_id,omitempty" json:"participant_id,omitempty"`
+
+	// EventId Event ID for text/calendar uploads
+	EventId string `form:"event_id,omitempty" json:"event_id,omitempty"`
+
+	// Zone Time zone for floating times and all-day events, defaults to UTC
+	Zone string `form:"zone,omitempty" json:"zone,omitempty"`
+}
+
+// UpdateParticipantAvailabilityJSONBody defines parameters for UpdateParticipantAvailability.
+type UpdateParticipantAvailabilityJSONBody struct {
+	EventId string             `json:"event_id,omitempty"`
+	Slots   []AvailabilitySlot `json:"slots,omitempty"`
+}
+
+// PatchEventApplicationMergePatchPlusJSONBody defines parameters for PatchEvent.
+type PatchEventApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// ExportEventParams defines parameters for ExportEvent.
+type ExportEventParams struct {
+	Format ExportEventParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportEventParamsFormat defines parameters for ExportEvent.
+type ExportEventParamsFormat string
+
+// FinalizeEventJSONBody defines parameters for FinalizeEvent.
+type FinalizeEventJSONBody struct {
+	EndTime time.Time `json:"end_time,omitempty"`
+
+	// Exdates Start times of occurrences to skip
+	Exdates []time.Time `json:"exdates,omitempty"`
+	Id      string      `json:"id,omitempty"`
+
+	// Rrule Optional RFC 5545 recurrence rule; the slot repeats within the event's horizon
+	Rrule     string    `json:"rrule,omitempty"`
+	StartTime time.Time `json:"start_time,omitempty"`
+
+	// Zone IANA time zone the rule is expanded in, so occurrences keep their wall clock time across DST changes; defaults to the offset of start_time
+	Zone string `json:"zone,omitempty"`
+}
+
+// GetEventHeatmapParams defines parameters for GetEventHeatmap.
+type GetEventHeatmapParams struct {
+	// Interval Bucket size as a Go duration, at least 5m
+	Interval string `form:"interval,omitempty" json:"interval,omitempty"`
+}
+
+// LiveEventParams defines parameters for LiveEvent.
+type LiveEventParams struct {
+	// Since The last version a reconnecting client saw. The server sends the participants that changed since then, or a snapshot if it no longer knows every change.
+	Since *int64 `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// RemoveParticipantSlotsParams defines parameters for RemoveParticipantSlots.
+type RemoveParticipantSlotsParams struct {
+	StartTime time.Time `form:"start_time" json:"start_time"`
+	EndTime   time.Time `form:"end_time" json:"end_time"`
+}
+
+// ReplaceParticipantSlotsJSONBody defines parameters for ReplaceParticipantSlots.
+type ReplaceParticipantSlotsJSONBody = []AvailabilitySlot
+
+// SubmitAvailabilityPageFormdataRequestBody defines body for SubmitAvailabilityPage for application/x-www-form-urlencoded ContentType.
+type SubmitAvailabilityPageFormdataRequestBody SubmitAvailabilityPageFormdataBody
+
+// ExecuteGraphQLJSONRequestBody defines body for ExecuteGraphQL for application/json ContentType.
+type ExecuteGraphQLJSONRequestBody ExecuteGraphQLJSONBody
+
+// CreateParticipantAvailabilityJSONRequestBody defines body for CreateParticipantAvailability for application/json ContentType.
+type CreateParticipantAvailabilityJSONRequestBody CreateParticipantAvailabilityJSONBody
+
+// CreateParticipantAvailabilityMultipartRequestBody defines body for CreateParticipantAvailability for multipart/form-data ContentType.
+type CreateParticipantAvailabilityMultipartRequestBody CreateParticipantAvailabilityMultipartBody
+
+// UpdateParticipantAvailabilityJSONRequestBody defines body for UpdateParticipantAvailability for application/json ContentType.
+type UpdateParticipantAvailabilityJSONRequestBody UpdateParticipantAvailabilityJSONBody
+
+// CreateEventJSONRequestBody defines body for CreateEvent for application/json ContentType.
+type CreateEventJSONRequestBody = Event
+
+// PatchEventApplicationMergePatchPlusJSONRequestBody defines body for PatchEvent for application/merge-patch+json ContentType.
+type PatchEventApplicationMergePatchPlusJSONRequestBody = PatchEventApplicationMergePatchPlusJSONBody
+
+// UpdateEventJSONRequestBody defines body for UpdateEvent for application/json ContentType.
+type UpdateEventJSONRequestBody = Event
+
+// FinalizeEventJSONRequestBody defines body for FinalizeEvent for application/json ContentType.
+type FinalizeEventJSONRequestBody FinalizeEventJSONBody
+
+// AddParticipantSlotJSONRequestBody defines body for AddParticipantSlot for application/json ContentType.
+type AddParticipantSlotJSONRequestBody = AvailabilitySlot
+
+// ReplaceParticipantSlotsJSONRequestBody defines body for ReplaceParticipantSlots for application/json ContentType.
+type ReplaceParticipantSlotsJSONRequestBody = ReplaceParticipantSlotsJSONBody
+
+// AddEventSlotJSONRequestBody defines body for AddEventSlot for application/json ContentType.
+type AddEventSlotJSONRequestBody = Slot
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Advertise CalDAV support
+	// (OPTIONS /caldav/{organizer}/)
+	CaldavOptions(w http.ResponseWriter, r *http.Request, organizer string)
+	// Get a single calendar object
+	// (GET /caldav/{organizer}/{name}.ics)
+	CaldavGetObject(w http.ResponseWriter, r *http.Request, organizer string, name string)
+	// Interactive API explorer for this document
+	// (GET /docs)
+	GetAPIDocs(w http.ResponseWriter, r *http.Request)
+	// A script, stylesheet or icon of the API explorer
+	// (GET /docs/assets/{file})
+	GetAPIDocsAsset(w http.ResponseWriter, r *http.Request, file GetAPIDocsAssetParamsFile)
+	// HTML reference for the API, generated from this document
+	// (GET /docs/reference)
+	GetAPIReference(w http.ResponseWriter, r *http.Request)
+	// HTML page where a participant paints their availability
+	// (GET /events/{id}/availability)
+	GetAvailabilityPage(w http.ResponseWriter, r *http.Request, id string, params GetAvailabilityPageParams)
+	// Save availability painted on the HTML page
+	// (POST /events/{id}/availability)
+	SubmitAvailabilityPage(w http.ResponseWriter, r *http.Request, id string)
+	// Run a GraphQL query or mutation
+	// (POST /graphql)
+	ExecuteGraphQL(w http.ResponseWriter, r *http.Request)
+	// This OpenAPI document
+	// (GET /openapi.yaml)
+	GetOpenAPIDocument(w http.ResponseWriter, r *http.Request)
+	// Create availability for a participant
+	// (POST /participant)
+	CreateParticipantAvailability(w http.ResponseWriter, r *http.Request, params CreateParticipantAvailabilityParams)
+	// Update availability of a participant
+	// (PUT /participant/{participant_id})
+	UpdateParticipantAvailability(w http.ResponseWriter, r *http.Request, participantId string)
+	// Delete availability of a participant for an event
+	// (DELETE /participant/{participant_id}/event/{id})
+	DeleteParticipantAvailability(w http.ResponseWriter, r *http.Request, participantId string, id string)
+	// Create a new event
+	// (POST /v1/events)
+	CreateEvent(w http.ResponseWriter, r *http.Request)
+	// Delete an event by ID
+	// (DELETE /v1/events/{id})
+	DeleteEvent(w http.ResponseWriter, r *http.Request, id string)
+	// Get an event by ID
+	// (GET /v1/events/{id})
+	GetEvent(w http.ResponseWriter, r *http.Request, id string)
+	// Patch an event by ID
+	// (PATCH /v1/events/{id})
+	PatchEvent(w http.ResponseWriter, r *http.Request, id string)
+	// Replace an event by ID
+	// (PUT /v1/events/{id})
+	UpdateEvent(w http.ResponseWriter, r *http.Request, id string)
+	// Export an event as iCalendar
+	// (GET /v1/events/{id}.ics)
+	GetEventCalendar(w http.ResponseWriter, r *http.Request, id string)
+	// Export an event with its availability and recommendations
+	// (GET /v1/events/{id}/export)
+	ExportEvent(w http.ResponseWriter, r *http.Request, id string, params ExportEventParams)
+	// Set the meeting time for an event
+	// (POST /v1/events/{id}/finalize)
+	FinalizeEvent(w http.ResponseWriter, r *http.Request, id string)
+	// Find common available slots for all participants
+	// (GET /v1/events/{id}/find-common-slots)
+	FindCommonSlots(w http.ResponseWriter, r *http.Request, id string)
+	// Get aggregated participant availability as a VFREEBUSY feed
+	// (GET /v1/events/{id}/freebusy)
+	GetEventFreeBusy(w http.ResponseWriter, r *http.Request, id string)
+	// Get an availability grid for the event
+	// (GET /v1/events/{id}/heatmap)
+	GetEventHeatmap(w http.ResponseWriter, r *http.Request, id string, params GetEventHeatmapParams)
+	// Edit availability together over a WebSocket
+	// (GET /v1/events/{id}/live)
+	LiveEvent(w http.ResponseWriter, r *http.Request, id string, params LiveEventParams)
+	// Bulk import participant availability from CSV
+	// (POST /v1/events/{id}/participants/import)
+	ImportAvailability(w http.ResponseWriter, r *http.Request, id string)
+	// Delete availability of a participant for an event
+	// (DELETE /v1/events/{id}/participants/{participant_id})
+	DeleteEventParticipant(w http.ResponseWriter, r *http.Request, id string, participantId string)
+	// Remove a block of time from a participant's availability
+	// (DELETE /v1/events/{id}/participants/{participant_id}/slots)
+	RemoveParticipantSlots(w http.ResponseWriter, r *http.Request, id string, participantId string, params RemoveParticipantSlotsParams)
+	// Get a participant's availability for an event
+	// (GET /v1/events/{id}/participants/{participant_id}/slots)
+	ListParticipantSlots(w http.ResponseWriter, r *http.Request, id string, participantId string)
+	// Add a block of time to a participant's availability
+	// (POST /v1/events/{id}/participants/{participant_id}/slots)
+	AddParticipantSlot(w http.ResponseWriter, r *http.Request, id string, participantId string)
+	// Replace a participant's availability for an event
+	// (PUT /v1/events/{id}/participants/{participant_id}/slots)
+	ReplaceParticipantSlots(w http.ResponseWriter, r *http.Request, id string, participantId string)
+	// List an event's candidate slots
+	// (GET /v1/events/{id}/slots)
+	ListEventSlots(w http.ResponseWriter, r *http.Request, id string)
+	// Add a candidate slot to an event
+	// (POST /v1/events/{id}/slots)
+	AddEventSlot(w http.ResponseWriter, r *http.Request, id string)
+	// Remove a candidate slot from an event
+	// (DELETE /v1/events/{id}/slots/{slot_id})
+	DeleteEventSlot(w http.ResponseWriter, r *http.Request, id string, slotId string)
+	// Stream changes to an event
+	// (GET /v1/events/{id}/stream)
+	StreamEvent(w http.ResponseWriter, r *http.Request, id string)
+	// Get availability of a participant
+	// (GET /v1/participants/{participant_id})
+	GetParticipantAvailability(w http.ResponseWriter, r *http.Request, participantId string)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// CaldavOptions operation middleware
+func (siw *ServerInterfaceWrapper) CaldavOptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organizer" -------------
+	var organizer string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizer", mux.Vars(r)["organizer"], &organizer, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizer", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CaldavOptions(w, r, organizer)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CaldavGetObject operation middleware
+func (siw *ServerInterfaceWrapper) CaldavGetObject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "organizer" -------------
+	var organizer string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizer", mux.Vars(r)["organizer"], &organizer, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizer", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CaldavGetObject(w, r, organizer, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIDocs operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIDocs(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIDocs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIDocsAsset operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIDocsAsset(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "file" -------------
+	var file GetAPIDocsAssetParamsFile
+
+	err = runtime.BindStyledParameterWithOptions("simple", "file", mux.Vars(r)["file"], &file, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIDocsAsset(w, r, file)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIReference operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIReference(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIReference(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAvailabilityPage operation middleware
+func (siw *ServerInterfaceWrapper) GetAvailabilityPage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAvailabilityPageParams
+
+	// ------------- Optional query parameter "participant_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "participant_id", r.URL.Query(), &params.ParticipantId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "participant_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "participant_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "zone" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "zone", r.URL.Query(), &params.Zone, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "zone"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "zone", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "saved" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "saved", r.URL.Query(), &params.Saved, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "saved"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "saved", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAvailabilityPage(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitAvailabilityPage operation middleware
+func (siw *ServerInterfaceWrapper) SubmitAvailabilityPage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitAvailabilityPage(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExecuteGraphQL operation middleware
+func (siw *ServerInterfaceWrapper) ExecuteGraphQL(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExecuteGraphQL(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOpenAPIDocument operation middleware
+func (siw *ServerInterfaceWrapper) GetOpenAPIDocument(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOpenAPIDocument(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateParticipantAvailability operation middleware
+func (siw *ServerInterfaceWrapper) CreateParticipantAvailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateParticipantAvailabilityParams
+
+	// ------------- Optional query parameter "participant_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "participant_id", r.URL.Query(), &params.ParticipantId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "participant_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "participant_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "event_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "event_id", r.URL.Query(), &params.EventId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "event_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "event_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "zone" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "zone", r.URL.Query(), &params.Zone, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "zone"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "zone", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateParticipantAvailability(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateParticipantAvailability operation middleware
+func (siw *ServerInterfaceWrapper) UpdateParticipantAvailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "participant_id" -------------
+	var participantId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "participant_id", mux.Vars(r)["participant_id"], &participantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "participant_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateParticipantAvailability(w, r, participantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteParticipantAvailability operation middleware
+func (siw *ServerInterfaceWrapper) DeleteParticipantAvailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "participant_id" -------------
+	var participantId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "participant_id", mux.Vars(r)["participant_id"], &participantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "participant_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteParticipantAvailability(w, r, participantId, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateEvent operation middleware
+func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEvent(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteEvent operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEvent(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEvent operation middleware
+func (siw *ServerInterfaceWrapper) GetEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEvent(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchEvent operation middleware
+func (siw *ServerInterfaceWrapper) PatchEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchEvent(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateEvent operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateEvent(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEventCalendar operation middleware
+func (siw *ServerInterfaceWrapper) GetEventCalendar(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEventCalendar(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportEvent operation middleware
+func (siw *ServerInterfaceWrapper) ExportEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportEventParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportEvent(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FinalizeEvent operation middleware
+func (siw *ServerInterfaceWrapper) FinalizeEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FinalizeEvent(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FindCommonSlots operation middleware
+func (siw *ServerInterfaceWrapper) FindCommonSlots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindCommonSlots(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEventFreeBusy operation middleware
+func (siw *ServerInterfaceWrapper) GetEventFreeBusy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEventFreeBusy(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEventHeatmap operation middleware
+func (siw *ServerInterfaceWrapper) GetEventHeatmap(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventHeatmapParams
+
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "interval", r.URL.Query(), &params.Interval, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "interval"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "interval", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEventHeatmap(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LiveEvent operation middleware
+func (siw *ServerInterfaceWrapper) LiveEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LiveEventParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "since", r.URL.Query(), &params.Since, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LiveEvent(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportAvailability operation middleware
+func (siw *ServerInterfaceWrapper) ImportAvailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportAvailability(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteEventParticipant operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEventParticipant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "participant_id" -------------
+	var participantId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "participant_id", mux.Vars(r)["participant_id"], &participantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "participant_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEventParticipant(w, r, id, participantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveParticipantSlots operation middleware
+func (siw *ServerInterfaceWrapper) RemoveParticipantSlots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "participant_id" -------------
+	var participantId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "participant_id", mux.Vars(r)["participant_id"], &participantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "participant_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RemoveParticipantSlotsParams
+
+	// ------------- Required query parameter "start_time" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "start_time", r.URL.Query(), &params.StartTime, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start_time"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_time", Err: err})
+		}
+		return
+	}
+
+	// ------------- Required query parameter "end_time" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "end_time", r.URL.Query(), &params.EndTime, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end_time"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_time", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveParticipantSlots(w, r, id, participantId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListParticipantSlots operation middleware
+func (siw *ServerInterfaceWrapper) ListParticipantSlots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "participant_id" -------------
+	var participantId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "participant_id", mux.Vars(r)["participant_id"], &participantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "participant_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListParticipantSlots(w, r, id, participantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddParticipantSlot operation middleware
+func (siw *ServerInterfaceWrapper) AddParticipantSlot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "participant_id" -------------
+	var participantId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "participant_id", mux.Vars(r)["participant_id"], &participantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "participant_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddParticipantSlot(w, r, id, participantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceParticipantSlots operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceParticipantSlots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "participant_id" -------------
+	var participantId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "participant_id", mux.Vars(r)["participant_id"], &participantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "participant_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceParticipantSlots(w, r, id, participantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListEventSlots operation middleware
+func (siw *ServerInterfaceWrapper) ListEventSlots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEventSlots(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddEventSlot operation middleware
+func (siw *ServerInterfaceWrapper) AddEventSlot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddEventSlot(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteEventSlot operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEventSlot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "slot_id" -------------
+	var slotId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slot_id", mux.Vars(r)["slot_id"], &slotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slot_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEventSlot(w, r, id, slotId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamEvent operation middleware
+func (siw *ServerInterfaceWrapper) StreamEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamEvent(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetParticipantAvailability operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantAvailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "participant_id" -------------
+	var participantId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "participant_id", mux.Vars(r)["participant_id"], &participantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "participant_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetParticipantAvailability(w, r, participantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{})
+}
+
+type GorillaServerOptions struct {
+	BaseURL          string
+	BaseRouter       *mux.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = mux.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.HandleFunc(options.BaseURL+"/caldav/{organizer}/", wrapper.CaldavOptions).Methods(http.MethodOptions)
+
+	r.HandleFunc(options.BaseURL+"/caldav/{organizer}/{name}.ics", wrapper.CaldavGetObject).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/docs", wrapper.GetAPIDocs).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/docs/assets/{file}", wrapper.GetAPIDocsAsset).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/docs/reference", wrapper.GetAPIReference).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/events/{id}/availability", wrapper.GetAvailabilityPage).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/events/{id}/availability", wrapper.SubmitAvailabilityPage).Methods(http.MethodPost)
+
+	r.HandleFunc(options.BaseURL+"/graphql", wrapper.ExecuteGraphQL).Methods(http.MethodPost)
+
+	r.HandleFunc(options.BaseURL+"/openapi.yaml", wrapper.GetOpenAPIDocument).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/participant", wrapper.CreateParticipantAvailability).Methods(http.MethodPost)
+
+	r.HandleFunc(options.BaseURL+"/participant/{participant_id}", wrapper.UpdateParticipantAvailability).Methods(http.MethodPut)
+
+	r.HandleFunc(options.BaseURL+"/participant/{participant_id}/event/{id}", wrapper.DeleteParticipantAvailability).Methods(http.MethodDelete)
+
+	r.HandleFunc(options.BaseURL+"/v1/events", wrapper.CreateEvent).Methods(http.MethodPost)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}", wrapper.DeleteEvent).Methods(http.MethodDelete)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}", wrapper.GetEvent).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}", wrapper.PatchEvent).Methods(http.MethodPatch)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}", wrapper.UpdateEvent).Methods(http.MethodPut)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}.ics", wrapper.GetEventCalendar).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/export", wrapper.ExportEvent).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/finalize", wrapper.FinalizeEvent).Methods(http.MethodPost)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/find-common-slots", wrapper.FindCommonSlots).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/freebusy", wrapper.GetEventFreeBusy).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/heatmap", wrapper.GetEventHeatmap).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/live", wrapper.LiveEvent).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/participants/import", wrapper.ImportAvailability).Methods(http.MethodPost)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/participants/{participant_id}", wrapper.DeleteEventParticipant).Methods(http.MethodDelete)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/participants/{participant_id}/slots", wrapper.RemoveParticipantSlots).Methods(http.MethodDelete)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/participants/{participant_id}/slots", wrapper.ListParticipantSlots).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/participants/{participant_id}/slots", wrapper.AddParticipantSlot).Methods(http.MethodPost)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/participants/{participant_id}/slots", wrapper.ReplaceParticipantSlots).Methods(http.MethodPut)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/slots", wrapper.ListEventSlots).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/slots", wrapper.AddEventSlot).Methods(http.MethodPost)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/slots/{slot_id}", wrapper.DeleteEventSlot).Methods(http.MethodDelete)
+
+	r.HandleFunc(options.BaseURL+"/v1/events/{id}/stream", wrapper.StreamEvent).Methods(http.MethodGet)
+
+	r.HandleFunc(options.BaseURL+"/v1/participants/{participant_id}", wrapper.GetParticipantAvailability).Methods(http.MethodGet)
+
+	return r
+}
@@ -0,0 +1,7 @@
+// Package api holds the router interface and the schema types generated from
+// openapi.yaml. The server implements the interface, and its REST handlers
+// read and write these types at the HTTP boundary. Run go generate after
+// changing the spec.
+package api
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.7.2 -config config.yaml ../openapi.yaml
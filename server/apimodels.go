@@ -0,0 +1,196 @@
+package main
+
+import (
+	"time"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// The REST handlers read and write the request and response models generated
+// from openapi.yaml, so their bodies cannot drift from the spec. The
+// scheduler keeps its own types, which the stream, GraphQL and gRPC APIs share;
+// these functions convert between the two.
+
+// Convert a candidate slot to its API model
+func apiSlot(slot Slot) api.Slot {
+	return api.Slot{
+		Id:        slot.ID,
+		StartTime: slot.StartTime,
+		EndTime:   slot.EndTime,
+		Rrule:     slot.RRule,
+		Zone:      slot.Zone,
+		Exdates:   slot.ExDates,
+	}
+}
+
+// Convert candidate slots to their API models. The list is never null.
+func apiSlots(slots []Slot) []api.Slot {
+	converted := make([]api.Slot, 0, len(slots))
+	for _, slot := range slots {
+		converted = append(converted, apiSlot(slot))
+	}
+	return converted
+}
+
+// Read a candidate slot from its API model
+func slotFromAPI(slot api.Slot) Slot {
+	return Slot{
+		ID:        slot.Id,
+		StartTime: slot.StartTime,
+		EndTime:   slot.EndTime,
+		RRule:     slot.Rrule,
+		Zone:      slot.Zone,
+		ExDates:   slot.Exdates,
+	}
+}
+
+// Read candidate slots from their API models
+func slotsFromAPI(slots []api.Slot) []Slot {
+	var converted []Slot
+	for _, slot := range slots {
+		converted = append(converted, slotFromAPI(slot))
+	}
+	return converted
+}
+
+// Convert availability to its API models. The list is never null.
+func apiAvailability(slots []Slot) []api.AvailabilitySlot {
+	converted := make([]api.AvailabilitySlot, 0, len(slots))
+	for _, slot := range slots {
+		converted = append(converted, api.AvailabilitySlot{
+			StartTime:  slot.StartTime,
+			EndTime:    slot.EndTime,
+			Rrule:      slot.RRule,
+			Zone:       slot.Zone,
+			Exdates:    slot.ExDates,
+			Preference: api.AvailabilitySlotPreference(slot.Preference),
+			SlotIds:    slot.SlotIDs,
+		})
+	}
+	return converted
+}
+
+// Read a block of availability from its API model. The slot IDs it overlaps
+// are worked out by the server, so any sent are ignored.
+func availabilitySlotFromAPI(slot api.AvailabilitySlot) Slot {
+	return Slot{
+		StartTime:  slot.StartTime,
+		EndTime:    slot.EndTime,
+		RRule:      slot.Rrule,
+		Zone:       slot.Zone,
+		ExDates:    slot.Exdates,
+		Preference: string(slot.Preference),
+	}
+}
+
+// Read availability from its API models
+func availabilityFromAPI(slots []api.AvailabilitySlot) []Slot {
+	var converted []Slot
+	for _, slot := range slots {
+		converted = append(converted, availabilitySlotFromAPI(slot))
+	}
+	return converted
+}
+
+// Convert an event to its API model
+func apiEvent(event Event) api.Event {
+	converted := api.Event{
+		Id:               event.ID,
+		Title:            event.Title,
+		Slots:            apiSlots(event.Slots),
+		EstimatedTime:    int64(event.EstimatedTime),
+		Participants:     event.Participants,
+		Organizer:        event.Organizer,
+		Horizon:          event.Horizon,
+		ResponseDeadline: event.ResponseDeadline,
+		AutoFinalize:     event.AutoFinalize,
+		Status:           api.EventStatus(event.Status),
+		AtRisk:           event.AtRisk,
+		Conflicts:        event.Conflicts,
+	}
+	if event.Series != nil {
+		converted.Series = &api.SeriesOptions{
+			IntervalWeeks: api.SeriesOptionsIntervalWeeks(event.Series.IntervalWeeks),
+			Occurrences:   event.Series.Occurrences,
+			Zone:          event.Series.Zone,
+		}
+	}
+	if event.FinalizedSlot != nil {
+		finalized := apiSlot(*event.FinalizedSlot)
+		converted.FinalizedSlot = &finalized
+	}
+	return converted
+}
+
+// Read the client-writable fields of an event from its API model. The fields
+// the server manages are left empty.
+func eventFromAPI(event api.Event) Event {
+	converted := Event{
+		Title:            event.Title,
+		Slots:            slotsFromAPI(event.Slots),
+		EstimatedTime:    time.Duration(event.EstimatedTime),
+		Participants:     event.Participants,
+		Organizer:        event.Organizer,
+		Horizon:          event.Horizon,
+		ResponseDeadline: event.ResponseDeadline,
+		AutoFinalize:     event.AutoFinalize,
+	}
+	if event.Series != nil {
+		converted.Series = &SeriesOptions{
+			IntervalWeeks: int(event.Series.IntervalWeeks),
+			Occurrences:   event.Series.Occurrences,
+			Zone:          event.Series.Zone,
+		}
+	}
+	return converted
+}
+
+// Convert a participant's availability for an event to its API model
+func apiParticipant(participant Participant) api.Participant {
+	return api.Participant{
+		Id:           participant.ID,
+		EventId:      participant.EventID,
+		Availability: apiAvailability(participant.Availability),
+	}
+}
+
+// Convert recommended slots to their API models. The list is never null.
+func apiSlotRecommendations(recommendations []SlotUnavailable) []api.SlotRecommendation {
+	converted := make([]api.SlotRecommendation, 0, len(recommendations))
+	for _, recommendation := range recommendations {
+		unavailable := recommendation.UnavailableParticipants
+		if unavailable == nil {
+			unavailable = []string{}
+		}
+		converted = append(converted, api.SlotRecommendation{
+			Slot:                    apiSlot(recommendation.Slot),
+			UnavailableParticipants: unavailable,
+		})
+	}
+	return converted
+}
+
+// Convert an event's recommendations to their API model
+func apiAvailabilityResponse(response AvailabilityResponse) api.AvailabilityResponse {
+	converted := api.AvailabilityResponse{
+		RecommendedTimeSlots: apiSlotRecommendations(response.RecommendedTimeSlots),
+	}
+	for _, series := range response.SeriesRecommendations {
+		converted.SeriesRecommendations = append(converted.SeriesRecommendations, api.SeriesRecommendation{
+			Slot:        apiSlot(series.Slot),
+			Score:       series.Score,
+			Attendance:  series.Attendance,
+			Occurrences: apiSlotRecommendations(series.Occurrences),
+		})
+	}
+	return converted
+}
+
+// Convert stored availability to its API model
+func apiAvailabilityUpdate(message string, update availabilityUpdate) api.AvailabilityUpdate {
+	return api.AvailabilityUpdate{
+		Message:      message,
+		Availability: apiAvailability(update.Availability),
+		Normalized:   update.Normalized,
+	}
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// apiServer implements the router interface generated from openapi.yaml, so
+// an operation added to the spec does not build until it has a handler. The
+// handlers take the path and query parameters the generated router bound.
+type apiServer struct{}
+
+var _ api.ServerInterface = apiServer{}
+
+func (apiServer) CreateEvent(w http.ResponseWriter, r *http.Request) {
+	createEvent(w, r)
+}
+
+func (apiServer) GetEvent(w http.ResponseWriter, r *http.Request, id string) {
+	getEvent(w, r, id)
+}
+
+func (apiServer) GetEventCalendar(w http.ResponseWriter, r *http.Request, id string) {
+	getEventCalendar(w, r, id)
+}
+
+func (apiServer) UpdateEvent(w http.ResponseWriter, r *http.Request, id string) {
+	updateEvent(w, r, id)
+}
+
+func (apiServer) PatchEvent(w http.ResponseWriter, r *http.Request, id string) {
+	patchEvent(w, r, id)
+}
+
+func (apiServer) DeleteEvent(w http.ResponseWriter, r *http.Request, id string) {
+	deleteEvent(w, r, id)
+}
+
+func (apiServer) FinalizeEvent(w http.ResponseWriter, r *http.Request, id string) {
+	finalizeEvent(w, r, id)
+}
+
+func (apiServer) ListEventSlots(w http.ResponseWriter, r *http.Request, id string) {
+	listEventSlots(w, r, id)
+}
+
+func (apiServer) AddEventSlot(w http.ResponseWriter, r *http.Request, id string) {
+	addEventSlot(w, r, id)
+}
+
+func (apiServer) DeleteEventSlot(w http.ResponseWriter, r *http.Request, id string, slotID string) {
+	deleteEventSlot(w, r, id, slotID)
+}
+
+func (apiServer) FindCommonSlots(w http.ResponseWriter, r *http.Request, id string) {
+	findCommonSlots(w, r, id)
+}
+
+func (apiServer) GetEventFreeBusy(w http.ResponseWriter, r *http.Request, id string) {
+	getEventFreeBusy(w, r, id)
+}
+
+func (apiServer) ExportEvent(w http.ResponseWriter, r *http.Request, id string, params api.ExportEventParams) {
+	exportEvent(w, r, id, params)
+}
+
+func (apiServer) GetEventHeatmap(w http.ResponseWriter, r *http.Request, id string, params api.GetEventHeatmapParams) {
+	getEventHeatmap(w, r, id, params)
+}
+
+func (apiServer) StreamEvent(w http.ResponseWriter, r *http.Request, id string) {
+	streamEvent(w, r, id)
+}
+
+func (apiServer) LiveEvent(w http.ResponseWriter, r *http.Request, id string, params api.LiveEventParams) {
+	liveEvent(w, r, id, params)
+}
+
+func (apiServer) GetParticipantAvailability(w http.ResponseWriter, r *http.Request, participantID string) {
+	getParticipantAvailability(w, r, participantID)
+}
+
+func (apiServer) ImportAvailability(w http.ResponseWriter, r *http.Request, id string) {
+	importAvailability(w, r, id)
+}
+
+func (apiServer) DeleteEventParticipant(w http.ResponseWriter, r *http.Request, id string, participantID string) {
+	deleteParticipantAvailability(w, r, id, participantID)
+}
+
+func (apiServer) ListParticipantSlots(w http.ResponseWriter, r *http.Request, id string, participantID string) {
+	listParticipantSlots(w, r, id, participantID)
+}
+
+func (apiServer) ReplaceParticipantSlots(w http.ResponseWriter, r *http.Request, id string, participantID string) {
+	replaceParticipantSlots(w, r, id, participantID)
+}
+
+func (apiServer) AddParticipantSlot(w http.ResponseWriter, r *http.Request, id string, participantID string) {
+	addParticipantSlot(w, r, id, participantID)
+}
+
+func (apiServer) RemoveParticipantSlots(w http.ResponseWriter, r *http.Request, id string, participantID string, params api.RemoveParticipantSlotsParams) {
+	removeParticipantSlots(w, r, id, participantID, params)
+}
+
+// Deprecated operations still described by the spec
+
+func (apiServer) CreateParticipantAvailability(w http.ResponseWriter, r *http.Request, params api.CreateParticipantAvailabilityParams) {
+	markDeprecated(w, r, "")
+	createParticipantAvailability(w, r, params)
+}
+
+func (apiServer) UpdateParticipantAvailability(w http.ResponseWriter, r *http.Request, participantID string) {
+	markDeprecated(w, r, "")
+	updateParticipantAvailability(w, r, participantID)
+}
+
+func (apiServer) DeleteParticipantAvailability(w http.ResponseWriter, r *http.Request, participantID string, id string) {
+	markDeprecated(w, r, "/v1/events/{id}/participants/{participant_id}")
+	deleteParticipantAvailability(w, r, id, participantID)
+}
+
+func (apiServer) ExecuteGraphQL(w http.ResponseWriter, r *http.Request) {
+	serveGraphQL(w, r)
+}
+
+// Docs, availability page and CalDAV
+
+func (apiServer) GetOpenAPIDocument(w http.ResponseWriter, r *http.Request) {
+	getOpenAPIDocument(w, r)
+}
+
+func (apiServer) GetAPIDocs(w http.ResponseWriter, r *http.Request) {
+	getAPIDocs(w, r)
+}
+
+func (apiServer) GetAPIReference(w http.ResponseWriter, r *http.Request) {
+	getAPIReference(w, r)
+}
+
+func (apiServer) GetAPIDocsAsset(w http.ResponseWriter, r *http.Request, file api.GetAPIDocsAssetParamsFile) {
+	getAPIDocsAsset(w, r, file)
+}
+
+func (apiServer) GetAvailabilityPage(w http.ResponseWriter, r *http.Request, id string, params api.GetAvailabilityPageParams) {
+	getAvailabilityPage(w, r, id, params)
+}
+
+func (apiServer) SubmitAvailabilityPage(w http.ResponseWriter, r *http.Request, id string) {
+	submitAvailabilityPage(w, r, id)
+}
+
+func (apiServer) CaldavOptions(w http.ResponseWriter, r *http.Request, organizer string) {
+	caldavCollection(w, r, organizer)
+}
+
+func (apiServer) CaldavGetObject(w http.ResponseWriter, r *http.Request, organizer string, name string) {
+	caldavObject(w, r, organizer, name)
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// A minimal CalDAV (RFC 4791) collection per organizer at /caldav/{organizer}/.
+// Every finalized meeting and candidate slot of the organizer's events is a
+// read-only calendar object resource named after its UID.
+
+type davMultistatus struct {
+	XMLName   xml.Name      `xml:"DAV: multistatus"`
+	Responses []davResponse `xml:"response"`
+}
+
+type davResponse struct {
+	Href     string      `xml:"href"`
+	Propstat davPropstat `xml:"propstat"`
+}
+
+type davPropstat struct {
+	Prop   davProp `xml:"prop"`
+	Status string  `xml:"status"`
+}
+
+type davProp struct {
+	ResourceType        *davResourceType       `xml:"resourcetype,omitempty"`
+	DisplayName         string                 `xml:"displayname,omitempty"`
+	GetETag             string                 `xml:"getetag,omitempty"`
+	GetContentType      string                 `xml:"getcontenttype,omitempty"`
+	GetCTag             string                 `xml:"http://calendarserver.org/ns/ getctag,omitempty"`
+	SupportedComponents *davSupportedComponent `xml:"urn:ietf:params:xml:ns:caldav supported-calendar-component-set,omitempty"`
+	CalendarData        string                 `xml:"urn:ietf:params:xml:ns:caldav calendar-data,omitempty"`
+}
+
+type davResourceType struct {
+	Collection *struct{} `xml:"collection,omitempty"`
+	Calendar   *struct{} `xml:"urn:ietf:params:xml:ns:caldav calendar,omitempty"`
+}
+
+type davSupportedComponent struct {
+	Comp []davComp `xml:"urn:ietf:params:xml:ns:caldav comp"`
+}
+
+type davComp struct {
+	Name string `xml:"name,attr"`
+}
+
+// davReport is a calendar-query or calendar-multiget REPORT body
+type davReport struct {
+	XMLName xml.Name
+	Filter  struct {
+		CompFilter davCompFilter `xml:"comp-filter"`
+	} `xml:"filter"`
+	Hrefs []string `xml:"href"`
+}
+
+type davCompFilter struct {
+	Name      string `xml:"name,attr"`
+	TimeRange *struct {
+		Start string `xml:"start,attr"`
+		End   string `xml:"end,attr"`
+	} `xml:"time-range"`
+	CompFilters []davCompFilter `xml:"comp-filter"`
+}
+
+// caldavResource is one calendar object resource in an organizer's collection
+type caldavResource struct {
+	Event Event
+	Entry calendarEntry
+}
+
+// Resource name for a calendar entry, the UID without its domain
+func (res caldavResource) name() string {
+	name, _, _ := strings.Cut(res.Entry.UID, "@")
+	return name + ".ics"
+}
+
+// Entity tag that changes whenever the underlying event does
+func (res caldavResource) etag() string {
+	data, _ := json.Marshal(res.Event)
+	sum := sha1.Sum(append(data, res.Entry.UID...))
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// Get every calendar object resource for the organizer's events. The caller must hold mu.
+func organizerResources(organizer string) []caldavResource {
+	var eventIDs []string
+	for eventID, event := range events {
+		if event.Organizer == organizer {
+			eventIDs = append(eventIDs, eventID)
+		}
+	}
+	sort.Strings(eventIDs)
+	var resources []caldavResource
+	for _, eventID := range eventIDs {
+		for _, entry := range eventCalendarEntries(events[eventID]) {
+			resources = append(resources, caldavResource{Event: events[eventID], Entry: entry})
+		}
+	}
+	return resources
+}
+
+// Maximum size of a REPORT request body
+const maxCalDAVReport = 1 << 20
+
+// CalDAV Collection Handler
+func caldavCollection(w http.ResponseWriter, r *http.Request, organizer string) {
+	// Read a REPORT body before locking, so a slow client does not hold up
+	// other requests
+	var report davReport
+	if r.Method == "REPORT" {
+		if err := xml.NewDecoder(http.MaxBytesReader(w, r.Body, maxCalDAVReport)).Decode(&report); err != nil {
+			writeProblem(w, problemInvalidInput, "")
+			return
+		}
+		if report.XMLName.Local != "calendar-query" && report.XMLName.Local != "calendar-multiget" {
+			writeProblem(w, problemInvalidInput, "Unsupported report "+report.XMLName.Local)
+			return
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	collection := "/caldav/" + organizer + "/"
+	resources := organizerResources(organizer)
+
+	switch r.Method {
+	case "OPTIONS":
+		w.Header().Set("DAV", "1, calendar-access")
+		w.Header().Set("Allow", "OPTIONS, GET, PROPFIND, REPORT")
+		w.WriteHeader(http.StatusOK)
+	case "PROPFIND":
+		// Describe the collection and, unless Depth is 0, its resources
+		ctag := sha1.New()
+		for _, res := range resources {
+			io.WriteString(ctag, res.etag())
+		}
+		ms := davMultistatus{Responses: []davResponse{{
+			Href: collection,
+			Propstat: davPropstat{
+				Prop: davProp{
+					ResourceType:        &davResourceType{Collection: &struct{}{}, Calendar: &struct{}{}},
+					DisplayName:         organizer,
+					GetCTag:             hex.EncodeToString(ctag.Sum(nil)),
+					SupportedComponents: &davSupportedComponent{Comp: []davComp{{Name: "VEVENT"}}},
+				},
+				Status: "HTTP/1.1 200 OK",
+			},
+		}}}
+		if r.Header.Get("Depth") != "0" {
+			for _, res := range resources {
+				ms.Responses = append(ms.Responses, caldavPropResponse(collection, res, false))
+			}
+		}
+		writeMultistatus(w, ms)
+	case "REPORT":
+		var ms davMultistatus
+		switch report.XMLName.Local {
+		case "calendar-query":
+			start, end := reportTimeRange(report.Filter.CompFilter)
+			for _, res := range resources {
+				if !overlapsRange(expandSlots([]Slot{res.Entry.Slot}, eventHorizon(res.Event)), start, end) {
+					continue
+				}
+				ms.Responses = append(ms.Responses, caldavPropResponse(collection, res, true))
+			}
+		case "calendar-multiget":
+			for _, href := range report.Hrefs {
+				for _, res := range resources {
+					if collection+res.name() == href {
+						ms.Responses = append(ms.Responses, caldavPropResponse(collection, res, true))
+					}
+				}
+			}
+		}
+		writeMultistatus(w, ms)
+	}
+}
+
+// CalDAV Calendar Object Handler
+func caldavObject(w http.ResponseWriter, r *http.Request, organizer string, name string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	name += ".ics"
+	for _, res := range organizerResources(organizer) {
+		if res.name() != name {
+			continue
+		}
+		if r.Method == "PROPFIND" {
+			writeMultistatus(w, davMultistatus{Responses: []davResponse{caldavPropResponse("/caldav/"+organizer+"/", res, false)}})
+			return
+		}
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("ETag", res.etag())
+		w.WriteHeader(http.StatusOK)
+		io.WriteString(w, eventCalendar(res.Event, res.Entry))
+		return
+	}
+	// If the resource does not exist, return a 404 error
+	writeProblem(w, problemNotFound, "Calendar object not found")
+}
+
+func caldavPropResponse(collection string, res caldavResource, withData bool) davResponse {
+	prop := davProp{
+		ResourceType:   &davResourceType{},
+		GetETag:        res.etag(),
+		GetContentType: "text/calendar; charset=utf-8; component=VEVENT",
+	}
+	if withData {
+		prop.CalendarData = eventCalendar(res.Event, res.Entry)
+	}
+	return davResponse{
+		Href:     collection + res.name(),
+		Propstat: davPropstat{Prop: prop, Status: "HTTP/1.1 200 OK"},
+	}
+}
+
+// Find the time-range of a calendar-query filter, if any
+func reportTimeRange(filter davCompFilter) (start time.Time, end time.Time) {
+	if filter.TimeRange != nil {
+		start, _ = time.Parse(icsTimeFormat, filter.TimeRange.Start)
+		end, _ = time.Parse(icsTimeFormat, filter.TimeRange.End)
+		return start, end
+	}
+	for _, child := range filter.CompFilters {
+		if start, end = reportTimeRange(child); !start.IsZero() || !end.IsZero() {
+			return start, end
+		}
+	}
+	return time.Time{}, time.Time{}
+}
+
+// Helper function to check if any of the slots overlaps a possibly open-ended range
+func overlapsRange(slots []Slot, start time.Time, end time.Time) bool {
+	for _, slot := range slots {
+		if (end.IsZero() || slot.StartTime.Before(end)) && (start.IsZero() || slot.EndTime.After(start)) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeMultistatus(w http.ResponseWriter, ms davMultistatus) {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	io.WriteString(w, xml.Header)
+	xml.NewEncoder(w).Encode(ms)
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-webdav/caldav"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalDAVCollection(t *testing.T) {
+	server := httptest.NewServer(setupRouter())
+	defer server.Close()
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	finalized := Slot{ID: "2", StartTime: start.Add(24 * time.Hour), EndTime: start.Add(25 * time.Hour)}
+	events["dav1"] = Event{
+		ID:    "dav1",
+		Title: "Brainstorming meeting",
+		Slots: []Slot{
+			{ID: "1", StartTime: start, EndTime: start.Add(time.Hour)},
+			finalized,
+		},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"bob"},
+		Organizer:     "carol",
+		FinalizedSlot: &finalized,
+	}
+	events["dav2"] = Event{
+		ID:        "dav2",
+		Title:     "Someone else's meeting",
+		Slots:     []Slot{{ID: "1", StartTime: start, EndTime: start.Add(time.Hour)}},
+		Organizer: "dave",
+	}
+
+	client, err := caldav.NewClient(http.DefaultClient, server.URL)
+	if err != nil {
+		t.Fatalf("could not create client: %v", err)
+	}
+	ctx := context.Background()
+
+	calendars, err := client.FindCalendars(ctx, "/caldav/carol/")
+	if err != nil {
+		t.Fatalf("could not find calendars: %v", err)
+	}
+	if assert.Len(t, calendars, 1) {
+		assert.Equal(t, "/caldav/carol/", calendars[0].Path)
+		assert.Equal(t, []string{"VEVENT"}, calendars[0].SupportedComponentSet)
+	}
+
+	// Only the finalized meeting and the slot it was picked from fall in this range
+	objects, err := client.QueryCalendar(ctx, "/caldav/carol/", &caldav.CalendarQuery{
+		CompRequest: caldav.CalendarCompRequest{Name: "VCALENDAR"},
+		CompFilter: caldav.CompFilter{
+			Name:  "VCALENDAR",
+			Comps: []caldav.CompFilter{{Name: "VEVENT", Start: start.Add(12 * time.Hour), End: start.Add(48 * time.Hour)}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("could not query calendar: %v", err)
+	}
+	if assert.Len(t, objects, 2) {
+		assert.Equal(t, "/caldav/carol/event-dav1-final.ics", objects[0].Path)
+		assert.Equal(t, "/caldav/carol/event-dav1-slot-2.ics", objects[1].Path)
+		vevents := objects[0].Data.Events()
+		if assert.Len(t, vevents, 1) {
+			status, _ := vevents[0].Props.Text("STATUS")
+			assert.Equal(t, "CONFIRMED", status)
+		}
+	}
+
+	object, err := client.GetCalendarObject(ctx, "/caldav/carol/event-dav1-slot-1.ics")
+	if err != nil {
+		t.Fatalf("could not get calendar object: %v", err)
+	}
+	assert.NotEmpty(t, object.ETag)
+	if vevents := object.Data.Events(); assert.Len(t, vevents, 1) {
+		dtstart, err := vevents[0].DateTimeStart(time.UTC)
+		assert.NoError(t, err)
+		assert.True(t, dtstart.Equal(start), "DTSTART should match the slot")
+	}
+
+	// Events of other organizers are not exposed
+	_, err = client.GetCalendarObject(ctx, "/caldav/carol/event-dav2-slot-1.ics")
+	assert.Error(t, err)
+}
+
+// A REPORT body that is still arriving must not hold up other requests
+func TestCalDAVReportDoesNotBlockOtherRequests(t *testing.T) {
+	router := setupRouter()
+	events["dav-slow"] = Event{ID: "dav-slow", Title: "Test Event", Organizer: "erin"}
+
+	body, upload := io.Pipe()
+	req := httptest.NewRequest("REPORT", "/caldav/erin/", body)
+	req.Header.Set("Content-Type", "application/xml")
+	rr := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		router.ServeHTTP(rr, req)
+	}()
+	io.WriteString(upload, `<?xml version="1.0"?><C:calendar-query xmlns:C="urn:ietf:params:xml:ns:caldav">`)
+
+	read := make(chan error)
+	go func() {
+		_, err := schedule.GetEvent("dav-slow")
+		read <- err
+	}()
+	select {
+	case err := <-read:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the event to be readable while the report is uploading")
+	}
+
+	io.WriteString(upload, `</C:calendar-query>`)
+	upload.Close()
+	<-done
+	assert.Equal(t, http.StatusMultiStatus, rr.Code, rr.Body.String())
+}
+
+func TestCalDAVReportTooLarge(t *testing.T) {
+	body := `<?xml version="1.0"?><C:calendar-query xmlns:C="urn:ietf:params:xml:ns:caldav"><!--` + strings.Repeat("x", maxCalDAVReport) + `--></C:calendar-query>`
+	req := httptest.NewRequest("REPORT", "/caldav/erin/", strings.NewReader(body))
+	rr := httptest.NewRecorder()
+	setupRouter().ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code, "Expected status code 400")
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Every operation in openapi.yaml is called at least once and every response
+// is checked against the spec, so handlers and spec cannot drift apart.
+func TestContract(t *testing.T) {
+	ctx := context.Background()
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromFile("openapi.yaml")
+	require.NoError(t, err)
+	require.NoError(t, doc.Validate(ctx))
+	specRouter, err := gorillamux.NewRouter(doc)
+	require.NoError(t, err)
+	for _, contentType := range []string{"text/calendar", "text/html", "application/xml", "text/css", "text/javascript", "image/png"} {
+		openapi3filter.RegisterBodyDecoder(contentType, openapi3filter.PlainBodyDecoder)
+	}
+
+	router := setupRouter()
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	deadline := start
+	events["contract"] = Event{
+		ID:            "contract",
+		Title:         "Contract meeting",
+		Slots:         []Slot{{ID: "1", StartTime: start, EndTime: start.Add(2 * time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"c1"},
+		Organizer:     "carl",
+		lastSlotID:    1,
+	}
+	events["contract-closed"] = Event{
+		ID:               "contract-closed",
+		Title:            "Closed meeting",
+		Slots:            []Slot{{ID: "1", StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime:    1 * time.Hour,
+		ResponseDeadline: &deadline,
+		Status:           EventStatusClosed,
+	}
+	for _, participantID := range []string{"c1", "c2", "c3"} {
+		delete(participants, participantID)
+	}
+	participants["c1"] = []Participant{{ID: "c1", EventID: "contract", Availability: []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}}}}
+
+	slotJSON := `{"start_time":"2025-01-13T14:00:00Z","end_time":"2025-01-13T15:00:00Z"}`
+	eventJSON := `{"title":"Contract meeting","slots":[{"id":"1","start_time":"2025-01-13T14:00:00Z","end_time":"2025-01-13T16:00:00Z"}],"estimatedTime":3600000000000,"participants":["c1","c2","c3"]}`
+	calendar := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//test//EN",
+		"BEGIN:VEVENT",
+		"UID:busy@test",
+		"DTSTAMP:20250101T000000Z",
+		"DTSTART:20250113T143000Z",
+		"DTEND:20250113T150000Z",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	cases := []struct {
+		method      string
+		path        string
+		contentType string
+		body        string
+		status      int
+	}{
+		// Events
+		{"POST", "/v1/events", "application/json", eventJSON, http.StatusCreated},
+		{"POST", "/v1/events", "application/json", `{`, http.StatusBadRequest},
+		{"POST", "/v1/events", "application/json", `{"title":""}`, http.StatusUnprocessableEntity},
+		{"GET", "/v1/events/contract", "", "", http.StatusOK},
+		{"GET", "/v1/events/missing", "", "", http.StatusNotFound},
+		{"PUT", "/v1/events/contract", "application/json", eventJSON, http.StatusOK},
+		{"PUT", "/v1/events/contract", "application/json", `{"title":""}`, http.StatusUnprocessableEntity},
+		{"PUT", "/v1/events/missing", "application/json", eventJSON, http.StatusNotFound},
+		{"PATCH", "/v1/events/contract", "application/merge-patch+json", `{"organizer":"carl"}`, http.StatusOK},
+		{"PATCH", "/v1/events/contract", "text/plain", `organizer`, http.StatusUnsupportedMediaType},
+		{"PATCH", "/v1/events/contract", "application/merge-patch+json", `{"status":"closed"}`, http.StatusUnprocessableEntity},
+		{"GET", "/v1/events/contract.ics", "", "", http.StatusOK},
+		{"GET", "/v1/events/missing.ics", "", "", http.StatusNotFound},
+
+		// Event slots
+		{"GET", "/v1/events/contract/slots", "", "", http.StatusOK},
+		{"POST", "/v1/events/contract/slots", "application/json", `{"start_time":"2025-01-14T14:00:00Z","end_time":"2025-01-14T16:00:00Z"}`, http.StatusCreated},
+		{"POST", "/v1/events/contract/slots", "application/json", `{}`, http.StatusUnprocessableEntity},
+		{"DELETE", "/v1/events/contract/slots/2", "", "", http.StatusNoContent},
+		{"DELETE", "/v1/events/contract/slots/9", "", "", http.StatusNotFound},
+		{"DELETE", "/v1/events/contract/slots/1", "", "", http.StatusUnprocessableEntity},
+
+		// Participant availability
+		{"PUT", "/v1/events/contract/participants/c2/slots", "application/json", "[" + slotJSON + "]", http.StatusCreated},
+		{"PUT", "/v1/events/contract/participants/c2/slots", "application/json", "[" + slotJSON + "]", http.StatusOK},
+		{"PUT", "/v1/events/contract-closed/participants/c2/slots", "application/json", "[" + slotJSON + "]", http.StatusForbidden},
+		{"POST", "/v1/events/contract/participants/c2/slots", "application/json", slotJSON, http.StatusCreated},
+		{"POST", "/v1/events/contract/participants/c2/slots", "application/json", `{}`, http.StatusUnprocessableEntity},
+		{"GET", "/v1/events/contract/participants/c2/slots", "", "", http.StatusOK},
+		{"GET", "/v1/events/contract/participants/c9/slots", "", "", http.StatusNotFound},
+		{"DELETE", "/v1/events/contract/participants/c2/slots?start_time=2025-01-13T14:00:00Z&end_time=2025-01-13T14:30:00Z", "", "", http.StatusOK},
+		{"DELETE", "/v1/events/contract/participants/c2/slots?start_time=2025-01-13T14:00:00Z&end_time=never", "", "", http.StatusBadRequest},
+		{"DELETE", "/v1/events/contract/participants/c2/slots?start_time=2025-01-13T14:30:00Z&end_time=2025-01-13T14:00:00Z", "", "", http.StatusUnprocessableEntity},
+		{"GET", "/v1/participants/c1", "", "", http.StatusOK},
+		{"GET", "/v1/participants/c9", "", "", http.StatusNotFound},
+		{"POST", "/v1/events/contract/participants/import", "text/csv", "participant_id,start,end\nc3,2025-01-13T14:00:00Z,2025-01-13T15:00:00Z", http.StatusOK},
+		{"POST", "/v1/events/contract/participants/import", "text/csv", "participant_id,start,end\nc3,never,2025-01-13T15:00:00Z", http.StatusUnprocessableEntity},
+		{"DELETE", "/v1/events/contract/participants/c3", "", "", http.StatusOK},
+		{"DELETE", "/v1/events/missing/participants/c3", "", "", http.StatusNotFound},
+
+		// Recommendations and exports
+		{"GET", "/v1/events/contract/find-common-slots", "", "", http.StatusOK},
+		{"GET", "/v1/events/missing/find-common-slots", "", "", http.StatusNotFound},
+		{"GET", "/v1/events/contract/freebusy", "", "", http.StatusOK},
+		{"GET", "/v1/events/contract/export", "", "", http.StatusOK},
+		{"GET", "/v1/events/contract/export?format=csv", "", "", http.StatusOK},
+		{"GET", "/v1/events/contract/heatmap?interval=15m", "", "", http.StatusOK},
+		{"GET", "/v1/events/contract/heatmap?interval=never", "", "", http.StatusBadRequest},
+		{"GET", "/v1/events/missing/stream", "", "", http.StatusNotFound},
+		{"GET", "/v1/events/contract/live", "", "", http.StatusBadRequest},
+		{"GET", "/v1/events/missing/live", "", "", http.StatusNotFound},
+		{"POST", "/v1/events/contract/finalize", "application/json", `{"id":"1"}`, http.StatusOK},
+		{"POST", "/v1/events/contract/finalize", "application/json", `{"id":"9"}`, http.StatusBadRequest},
+
+		// Deprecated participant routes
+		{"POST", "/participant", "application/json", `{"participant_id":"c3","event_id":"contract","slots":[` + slotJSON + `]}`, http.StatusOK},
+		{"POST", "/participant", "application/json", `{"participant_id":"c3","event_id":"contract","slots":[` + slotJSON + `]}`, http.StatusConflict},
+		{"POST", "/participant?participant_id=c4&event_id=contract", "text/calendar", calendar, http.StatusOK},
+		{"PUT", "/participant/c3", "application/json", `{"event_id":"contract","slots":[` + slotJSON + `]}`, http.StatusOK},
+		{"PUT", "/participant/c3", "application/json", `{"event_id":"contract-closed","slots":[` + slotJSON + `]}`, http.StatusForbidden},
+		{"DELETE", "/participant/c3/event/contract", "", "", http.StatusOK},
+		{"DELETE", "/participant/c3/event/missing", "", "", http.StatusNotFound},
+
+		// Availability page
+		{"GET", "/events/contract/availability?participant_id=c3", "", "", http.StatusOK},
+		{"GET", "/events/missing/availability", "", "", http.StatusNotFound},
+		{"POST", "/events/contract/availability", "application/x-www-form-urlencoded", "participant_id=c3&slot=2025-01-13T14:00:00Z/2025-01-13T14:30:00Z", http.StatusSeeOther},
+		{"POST", "/events/contract-closed/availability", "application/x-www-form-urlencoded", "participant_id=c3", http.StatusForbidden},
+
+		// GraphQL
+		{"POST", "/graphql", "application/json", `{"query":"{ event(id: \"contract\") { title participants { id } } }"}`, http.StatusOK},
+		{"POST", "/graphql", "application/json", `{`, http.StatusBadRequest},
+
+		// Docs
+		{"GET", "/openapi.yaml", "", "", http.StatusOK},
+		{"GET", "/docs", "", "", http.StatusOK},
+		{"GET", "/docs/reference", "", "", http.StatusOK},
+		{"GET", "/docs/assets/swagger-ui.css", "", "", http.StatusOK},
+		{"GET", "/docs/assets/favicon-16x16.png", "", "", http.StatusOK},
+		{"GET", "/docs/assets/swagger-ui.map", "", "", http.StatusNotFound},
+
+		// CalDAV
+		{"OPTIONS", "/caldav/carl/", "", "", http.StatusOK},
+		{"GET", "/caldav/carl/event-contract-final.ics", "", "", http.StatusOK},
+		{"GET", "/caldav/carl/event-missing-final.ics", "", "", http.StatusNotFound},
+
+		{"DELETE", "/v1/events/contract", "", "", http.StatusNoContent},
+		{"DELETE", "/v1/events/contract", "", "", http.StatusNotFound},
+	}
+
+	called := map[string]bool{}
+	for _, c := range cases {
+		name := c.method + " " + c.path
+		req := httptest.NewRequest(c.method, "http://localhost:8080"+c.path, strings.NewReader(c.body))
+		if c.contentType != "" {
+			req.Header.Set("Content-Type", c.contentType)
+		}
+		route, pathParams, err := specRouter.FindRoute(req)
+		if !assert.NoError(t, err, name) {
+			continue
+		}
+		called[route.Operation.OperationID] = true
+
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		if !assert.Equal(t, c.status, rr.Code, "%s: %s", name, rr.Body.String()) {
+			continue
+		}
+		err = openapi3filter.ValidateResponse(ctx, &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: &openapi3filter.RequestValidationInput{
+				Request:    req,
+				PathParams: pathParams,
+				Route:      route,
+			},
+			Status: rr.Code,
+			Header: rr.Header(),
+			Body:   io.NopCloser(bytes.NewReader(rr.Body.Bytes())),
+			Options: &openapi3filter.Options{
+				IncludeResponseStatus: true,
+			},
+		})
+		assert.NoError(t, err, name)
+	}
+
+	for path, item := range doc.Paths.Map() {
+		for method, operation := range item.Operations() {
+			assert.True(t, called[operation.OperationID], "%s %s is not covered", method, path)
+		}
+	}
+}
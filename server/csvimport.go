@@ -0,0 +1,180 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// Maximum size of an uploaded CSV file
+const maxCSVUpload = 10 << 20
+
+// Columns a CSV availability import must have
+var requiredCSVColumns = []string{"participant_id", "start", "end"}
+
+// Slot preferences a CSV row may carry
+var slotPreferences = []string{"", "preferred", "available", "if_needed"}
+
+// Bulk Availability Import Handler
+func importAvailability(w http.ResponseWriter, r *http.Request, eventID string) {
+	// Parse and validate every row before writing anything, and before
+	// locking so a slow upload does not hold up other requests
+	availability, err := readAvailabilityCSV(http.MaxBytesReader(w, r.Body, maxCSVUpload))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	imported, err := schedule.ImportAvailability(eventID, availability)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Respond with success
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(api.AvailabilityImport{
+		Message:      "Availability imported successfully",
+		Participants: imported.Participants,
+		Slots:        imported.Slots,
+		Normalized:   imported.Normalized,
+	})
+}
+
+// Read an availability CSV, reporting a file that cannot be read or any
+// invalid rows as a problem
+func readAvailabilityCSV(r io.Reader) (csvAvailability, error) {
+	availability, rowErrors, err := parseAvailabilityCSV(r)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return csvAvailability{}, newProblem(problemInvalidInput, "The CSV file is too large")
+	}
+	if err != nil {
+		return csvAvailability{}, newProblem(problemInvalidInput, err.Error())
+	}
+	if len(rowErrors) > 0 {
+		return csvAvailability{}, newProblem(problemValidation, "Invalid rows, nothing was imported", rowErrors...)
+	}
+	return availability, nil
+}
+
+// csvAvailability is the availability read from an import, by participant
+type csvAvailability struct {
+	order []string
+	slots map[string][]Slot
+}
+
+// Read an availability CSV with a header row naming its columns. Problems with
+// individual rows are collected rather than stopping at the first one.
+func parseAvailabilityCSV(r io.Reader) (csvAvailability, []FieldError, error) {
+	availability := csvAvailability{slots: map[string][]Slot{}}
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return availability, nil, fmt.Errorf("empty CSV")
+	}
+	if err != nil {
+		return availability, nil, err
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range requiredCSVColumns {
+		if _, ok := columns[name]; !ok {
+			return availability, nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var rowErrors []FieldError
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		// A malformed row is reported with the others, but a file that cannot
+		// be read any further is not
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rowErrors = append(rowErrors, FieldError{Row: row, Message: err.Error()})
+			continue
+		}
+		if err != nil {
+			return availability, nil, err
+		}
+
+		participantID := field(record, "participant_id")
+		if participantID == "" {
+			rowErrors = append(rowErrors, FieldError{Row: row, Field: "participant_id", Message: "participant_id is required"})
+			continue
+		}
+		loc := time.UTC
+		if zone := field(record, "zone"); zone != "" {
+			if loc, err = time.LoadLocation(zone); err != nil {
+				rowErrors = append(rowErrors, FieldError{Row: row, Field: "zone", Message: fmt.Sprintf("unknown zone %q", zone)})
+				continue
+			}
+		}
+		start, err := parseCSVTime(field(record, "start"), loc)
+		if err != nil {
+			rowErrors = append(rowErrors, FieldError{Row: row, Field: "start", Message: err.Error()})
+			continue
+		}
+		end, err := parseCSVTime(field(record, "end"), loc)
+		if err != nil {
+			rowErrors = append(rowErrors, FieldError{Row: row, Field: "end", Message: err.Error()})
+			continue
+		}
+		if !end.After(start) {
+			rowErrors = append(rowErrors, FieldError{Row: row, Field: "end", Message: "end must be after start"})
+			continue
+		}
+		preference := strings.ToLower(field(record, "preference"))
+		if !containsString(slotPreferences, preference) {
+			rowErrors = append(rowErrors, FieldError{Row: row, Field: "preference", Message: "preference must be one of preferred, available or if_needed"})
+			continue
+		}
+
+		if _, seen := availability.slots[participantID]; !seen {
+			availability.order = append(availability.order, participantID)
+		}
+		availability.slots[participantID] = append(availability.slots[participantID], Slot{
+			StartTime:  start,
+			EndTime:    end,
+			Preference: preference,
+		})
+	}
+	return availability, rowErrors, nil
+}
+
+// Parse an RFC 3339 time, or a local "2006-01-02 15:04" time in loc
+func parseCSVTime(value string, loc *time.Location) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, fmt.Errorf("time is required")
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02 15:04:05", "2006-01-02T15:04:05"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", value)
+}
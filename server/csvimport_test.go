@@ -0,0 +1,150 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportAvailabilityCSV(t *testing.T) {
+	router := setupRouter()
+
+	events["csv"] = Event{
+		ID:            "csv",
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 13, 17, 0, 0, 0, time.UTC)}},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"csv1"},
+	}
+	participants["csv1"] = []Participant{{ID: "csv1", EventID: "csv"}}
+	delete(participants, "csv2")
+
+	body := strings.Join([]string{
+		"participant_id,start,end,zone,preference",
+		"csv1,2025-01-13T14:00:00Z,2025-01-13T15:00:00Z,,preferred",
+		"csv2,2025-01-13 09:00,2025-01-13 11:00,America/New_York,",
+		"csv2,2025-01-13T16:00:00Z,2025-01-13T17:00:00Z,,if_needed",
+	}, "\n")
+	req, err := http.NewRequest("POST", "/event/csv/availability/import", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "text/csv")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	assert.Equal(t, []string{"csv1", "csv2"}, events["csv"].Participants)
+	if assert.Len(t, participants["csv1"], 1) {
+		assert.Len(t, participants["csv1"][0].Availability, 1)
+		assert.Equal(t, "preferred", participants["csv1"][0].Availability[0].Preference)
+	}
+	if assert.Len(t, participants["csv2"], 1) && assert.Len(t, participants["csv2"][0].Availability, 2) {
+		assert.True(t, participants["csv2"][0].Availability[0].StartTime.Equal(time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)),
+			"Local times should be read in the row's zone")
+	}
+}
+
+func TestImportAvailabilityCSVRejectsInvalidRows(t *testing.T) {
+	router := setupRouter()
+
+	events["csvbad"] = Event{ID: "csvbad", Title: "Test Event", EstimatedTime: 1 * time.Hour}
+	delete(participants, "ok")
+
+	body := strings.Join([]string{
+		"participant_id,start,end",
+		"ok,2025-01-13T14:00:00Z,2025-01-13T15:00:00Z",
+		",2025-01-13T14:00:00Z,2025-01-13T15:00:00Z",
+		"late,2025-01-13T15:00:00Z,2025-01-13T14:00:00Z",
+	}, "\n")
+	req, err := http.NewRequest("POST", "/event/csvbad/availability/import", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, "Expected status code 422")
+	assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+	var response Problem
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	assert.Equal(t, []FieldError{
+		{Row: 3, Field: "participant_id", Message: "participant_id is required"},
+		{Row: 4, Field: "end", Message: "end must be after start"},
+	}, response.Errors)
+	assert.Empty(t, participants["ok"], "Nothing should be imported when a row is invalid")
+}
+
+func TestImportAvailabilityCSVTooLarge(t *testing.T) {
+	router := setupRouter()
+
+	events["csvlarge"] = Event{ID: "csvlarge", Title: "Test Event", EstimatedTime: 1 * time.Hour}
+	delete(participants, "big")
+
+	// Every row is valid, so a file cut short at the limit would still import
+	row := "big,2025-01-13T14:00:00Z,2025-01-13T15:00:00Z\n"
+	body := "participant_id,start,end\n" + strings.Repeat(row, maxCSVUpload/len(row)+1)
+	req, err := http.NewRequest("POST", "/v1/events/csvlarge/participants/import", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code, "Expected status code 400")
+	var response Problem
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	assert.Equal(t, "The CSV file is too large", response.Detail)
+	assert.Empty(t, participants["big"], "Nothing should be imported from a file that is too large")
+}
+
+// An import that is still uploading must not hold up other requests
+func TestImportAvailabilityCSVDoesNotBlockOtherRequests(t *testing.T) {
+	router := setupRouter()
+	events["csv-slow"] = Event{
+		ID:            "csv-slow",
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 13, 17, 0, 0, 0, time.UTC)}},
+		EstimatedTime: 1 * time.Hour,
+	}
+	delete(participants, "csv3")
+
+	body, upload := io.Pipe()
+	req := httptest.NewRequest("POST", "/event/csv-slow/availability/import", body)
+	req.Header.Set("Content-Type", "text/csv")
+	rr := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		router.ServeHTTP(rr, req)
+	}()
+	io.WriteString(upload, "participant_id,start,end\n")
+
+	read := make(chan error)
+	go func() {
+		_, err := schedule.GetEvent("csv-slow")
+		read <- err
+	}()
+	select {
+	case err := <-read:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the event to be readable while the CSV is uploading")
+	}
+
+	io.WriteString(upload, "csv3,2025-01-13T14:00:00Z,2025-01-13T15:00:00Z\n")
+	upload.Close()
+	<-done
+	assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, []string{"csv3"}, events["csv-slow"].Participants)
+}
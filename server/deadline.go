@@ -0,0 +1,65 @@
+package main
+
+import (
+	"log"
+	"time"
+)
+
+// Clock abstracts the current time so deadlines can be tested
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+// clock is the time source used for response deadlines
+var clock Clock = realClock{}
+
+// Helper function to check if an event no longer accepts availability responses
+func isEventClosed(event Event) bool {
+	if event.Status == EventStatusClosed {
+		return true
+	}
+	return event.ResponseDeadline != nil && !clock.Now().Before(*event.ResponseDeadline)
+}
+
+// Close every open event whose response deadline has passed, finalizing the
+// top recommended slot for events that asked for it. The caller must hold mu.
+func closeExpiredEvents() {
+	for eventID, event := range events {
+		if event.Status == EventStatusClosed || !isEventClosed(event) {
+			continue
+		}
+		event.Status = EventStatusClosed
+		var availability map[string]ParticipantAvailability
+		if event.AutoFinalize && event.FinalizedSlot == nil {
+			availability = eventAvailability(eventID, event)
+			if recommended := recommendSlots(event, availability); len(recommended) > 0 {
+				slot := recommended[0].Slot
+				event.FinalizedSlot = &slot
+			}
+		}
+		events[eventID] = event
+		revalidateFinalizedSlot(eventID, availability)
+		publishEvent(eventID)
+		log.Printf("Event %s closed after response deadline", eventID)
+	}
+}
+
+// Periodically close expired events until stop is closed
+func startDeadlineScheduler(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			mu.Lock()
+			closeExpiredEvents()
+			mu.Unlock()
+		case <-stop:
+			return
+		}
+	}
+}
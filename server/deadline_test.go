@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a Clock whose time only moves when told to
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func useFakeClock(t *testing.T, now time.Time) *fakeClock {
+	fc := &fakeClock{now: now}
+	clock = fc
+	t.Cleanup(func() { clock = realClock{} })
+	return fc
+}
+
+func TestAvailabilityRejectedAfterDeadline(t *testing.T) {
+	router := setupRouter()
+	start := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+	fc := useFakeClock(t, start)
+
+	deadline := start.Add(24 * time.Hour)
+	events["deadline"] = Event{
+		ID:               "deadline",
+		Title:            "Test Event",
+		Slots:            []Slot{{StartTime: start.Add(48 * time.Hour), EndTime: start.Add(49 * time.Hour)}},
+		EstimatedTime:    1 * time.Hour,
+		ResponseDeadline: &deadline,
+		Status:           EventStatusOpen,
+	}
+	delete(participants, "late")
+
+	// Move past the deadline before responding
+	fc.Advance(25 * time.Hour)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"participant_id": "late",
+		"event_id":       "deadline",
+		"slots":          events["deadline"].Slots,
+	})
+	req, err := http.NewRequest("POST", "/participant", bytes.NewBuffer(body))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusForbidden, rr.Code, "Expected status code 403")
+	assert.Empty(t, participants["late"], "Availability should not be recorded")
+}
+
+func TestCloseExpiredEventsAutoFinalizes(t *testing.T) {
+	start := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+	fc := useFakeClock(t, start)
+
+	deadline := start.Add(time.Hour)
+	slotA := Slot{StartTime: start.Add(48 * time.Hour), EndTime: start.Add(49 * time.Hour)}
+	slotB := Slot{StartTime: start.Add(72 * time.Hour), EndTime: start.Add(73 * time.Hour)}
+	events["finalize"] = Event{
+		ID:               "finalize",
+		Title:            "Test Event",
+		Slots:            []Slot{slotA, slotB},
+		EstimatedTime:    1 * time.Hour,
+		Participants:     []string{"p1"},
+		ResponseDeadline: &deadline,
+		AutoFinalize:     true,
+		Status:           EventStatusOpen,
+	}
+	participants["p1"] = []Participant{{ID: "p1", EventID: "finalize", Availability: []Slot{slotB}}}
+
+	// Nothing happens before the deadline
+	closeExpiredEvents()
+	assert.Equal(t, EventStatusOpen, events["finalize"].Status)
+
+	fc.Advance(2 * time.Hour)
+	closeExpiredEvents()
+
+	event := events["finalize"]
+	assert.Equal(t, EventStatusClosed, event.Status)
+	if assert.NotNil(t, event.FinalizedSlot) {
+		assert.True(t, event.FinalizedSlot.StartTime.Equal(slotB.StartTime), "Top recommendation should be finalized")
+	}
+}
+
+// Auto-finalize picks a slot the participants can attend for the whole
+// meeting, not one they only partly overlap, so it is not at risk right away
+func TestCloseExpiredEventsAutoFinalizesAttendableSlot(t *testing.T) {
+	start := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+	fc := useFakeClock(t, start)
+
+	deadline := start.Add(time.Hour)
+	slotA := Slot{StartTime: start.Add(48 * time.Hour), EndTime: start.Add(49 * time.Hour)}
+	slotB := Slot{StartTime: start.Add(72 * time.Hour), EndTime: start.Add(73 * time.Hour)}
+	events["finalize-partial"] = Event{
+		ID:               "finalize-partial",
+		Title:            "Test Event",
+		Slots:            []Slot{slotA, slotB},
+		EstimatedTime:    1 * time.Hour,
+		Participants:     []string{"pp1", "pp2"},
+		ResponseDeadline: &deadline,
+		AutoFinalize:     true,
+		Status:           EventStatusOpen,
+	}
+	// pp1 only has the first quarter hour of slot A
+	participants["pp1"] = []Participant{{ID: "pp1", EventID: "finalize-partial", Availability: []Slot{
+		{StartTime: slotA.StartTime, EndTime: slotA.StartTime.Add(15 * time.Minute)}, slotB,
+	}}}
+	participants["pp2"] = []Participant{{ID: "pp2", EventID: "finalize-partial", Availability: []Slot{slotA, slotB}}}
+
+	fc.Advance(2 * time.Hour)
+	closeExpiredEvents()
+
+	event := events["finalize-partial"]
+	if assert.NotNil(t, event.FinalizedSlot) {
+		assert.True(t, event.FinalizedSlot.StartTime.Equal(slotB.StartTime), "The slot everyone can attend should be finalized")
+	}
+	assert.False(t, event.AtRisk, "The finalized slot should not be at risk")
+}
+
+func TestExtendingDeadlineReopensEvent(t *testing.T) {
+	start := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+	fc := useFakeClock(t, start)
+
+	deadline := start.Add(time.Hour)
+	events["extend"] = Event{
+		ID:               "extend",
+		Title:            "Test Event",
+		Slots:            []Slot{{StartTime: start.Add(48 * time.Hour), EndTime: start.Add(49 * time.Hour)}},
+		EstimatedTime:    1 * time.Hour,
+		ResponseDeadline: &deadline,
+		Status:           EventStatusOpen,
+	}
+	delete(participants, "extended")
+	fc.Advance(2 * time.Hour)
+	mu.Lock()
+	closeExpiredEvents()
+	mu.Unlock()
+	assert.Equal(t, EventStatusClosed, events["extend"].Status)
+
+	// Moving the deadline into the future reopens the event for responses
+	update := events["extend"]
+	later := fc.Now().Add(24 * time.Hour)
+	update.ResponseDeadline = &later
+	event, err := schedule.UpdateEvent("extend", update)
+	assert.NoError(t, err)
+	assert.Equal(t, EventStatusOpen, event.Status)
+	_, err = schedule.ReplaceParticipantSlots("extend", "extended", update.Slots)
+	assert.NoError(t, err)
+
+	// A deadline that is still in the past keeps it closed
+	fc.Advance(48 * time.Hour)
+	mu.Lock()
+	closeExpiredEvents()
+	mu.Unlock()
+	update = events["extend"]
+	earlier := fc.Now().Add(-time.Hour)
+	update.ResponseDeadline = &earlier
+	event, err = schedule.UpdateEvent("extend", update)
+	assert.NoError(t, err)
+	assert.Equal(t, EventStatusClosed, event.Status)
+}
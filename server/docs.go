@@ -0,0 +1,206 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+	"github.com/getkin/kin-openapi/openapi3"
+	swaggerfiles "github.com/swaggo/files/v2"
+)
+
+// The OpenAPI document is built into the binary so the server always serves
+// the spec it was generated from
+//
+//go:embed openapi.yaml
+var openAPIDocument []byte
+
+// Order operations are listed in on the docs page
+var docsMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+
+type apiDocs struct {
+	Title       string
+	Version     string
+	Description string
+	Operations  []docsOperation
+	Schemas     []docsSchema
+}
+
+type docsOperation struct {
+	ID          string
+	Method      string
+	Path        string
+	Summary     string
+	Description string
+	Deprecated  bool
+	Parameters  []docsParameter
+	RequestBody []docsContent
+	Responses   []docsResponse
+}
+
+type docsParameter struct {
+	Name        string
+	In          string
+	Required    bool
+	Description string
+	Schema      string
+}
+
+type docsContent struct {
+	Type   string
+	Schema string
+}
+
+type docsResponse struct {
+	Status      string
+	Description string
+	Content     []docsContent
+}
+
+type docsSchema struct {
+	Name   string
+	Schema string
+}
+
+// Built once at startup, like the page templates
+var apiDocsPage = loadAPIDocs(openAPIDocument)
+
+// OpenAPI Document Handler
+func getOpenAPIDocument(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	w.Write(openAPIDocument)
+}
+
+// API Docs Handler. The page runs Swagger UI on the OpenAPI document, so
+// operations can be tried from the browser.
+func getAPIDocs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	pageTemplates.ExecuteTemplate(w, "explorer.html", apiDocsPage)
+}
+
+// API Reference Handler. The page is plain HTML with no scripts or external
+// assets, for browsers without JavaScript.
+func getAPIReference(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	pageTemplates.ExecuteTemplate(w, "docs.html", apiDocsPage)
+}
+
+// API Docs Asset Handler
+func getAPIDocsAsset(w http.ResponseWriter, r *http.Request, file api.GetAPIDocsAssetParamsFile) {
+	// Only the files the explorer uses, listed in the spec, are served from
+	// the bundle built into the binary, so the explorer works offline
+	if !file.Valid() {
+		writeProblem(w, problemNotFound, "")
+		return
+	}
+	http.ServeFileFS(w, r, swaggerfiles.FS, string(file))
+}
+
+// Turn the OpenAPI document into the docs page contents. The spec is embedded
+// at build time, so failing to load it is a programming error.
+func loadAPIDocs(data []byte) apiDocs {
+	doc, err := openapi3.NewLoader().LoadFromData(data)
+	if err != nil {
+		panic("openapi.yaml: " + err.Error())
+	}
+	docs := apiDocs{Title: doc.Info.Title, Version: doc.Info.Version, Description: doc.Info.Description}
+	paths := doc.Paths.Map()
+	keys := make([]string, 0, len(paths))
+	for path := range paths {
+		keys = append(keys, path)
+	}
+	sort.Strings(keys)
+	for _, path := range keys {
+		item := paths[path]
+		for _, method := range docsMethods {
+			operation := item.GetOperation(method)
+			if operation == nil {
+				continue
+			}
+			docs.Operations = append(docs.Operations, docsOperationFor(method, path, item, operation))
+		}
+	}
+	for name, schema := range doc.Components.Schemas {
+		docs.Schemas = append(docs.Schemas, docsSchema{Name: name, Schema: schemaJSON(schema)})
+	}
+	sort.Slice(docs.Schemas, func(i, j int) bool {
+		return docs.Schemas[i].Name < docs.Schemas[j].Name
+	})
+	return docs
+}
+
+// Describe one operation, including the parameters shared by its path
+func docsOperationFor(method string, path string, item *openapi3.PathItem, operation *openapi3.Operation) docsOperation {
+	op := docsOperation{
+		ID:          operation.OperationID,
+		Method:      method,
+		Path:        path,
+		Summary:     operation.Summary,
+		Description: operation.Description,
+		Deprecated:  operation.Deprecated,
+	}
+	for _, parameter := range append(append(openapi3.Parameters(nil), item.Parameters...), operation.Parameters...) {
+		if parameter.Value == nil {
+			continue
+		}
+		op.Parameters = append(op.Parameters, docsParameter{
+			Name:        parameter.Value.Name,
+			In:          parameter.Value.In,
+			Required:    parameter.Value.Required,
+			Description: parameter.Value.Description,
+			Schema:      schemaJSON(parameter.Value.Schema),
+		})
+	}
+	if operation.RequestBody != nil && operation.RequestBody.Value != nil {
+		op.RequestBody = docsContentFor(operation.RequestBody.Value.Content)
+	}
+	for status, response := range operation.Responses.Map() {
+		if response.Value == nil {
+			continue
+		}
+		description := ""
+		if response.Value.Description != nil {
+			description = *response.Value.Description
+		}
+		op.Responses = append(op.Responses, docsResponse{
+			Status:      status,
+			Description: description,
+			Content:     docsContentFor(response.Value.Content),
+		})
+	}
+	sort.Slice(op.Responses, func(i, j int) bool {
+		return op.Responses[i].Status < op.Responses[j].Status
+	})
+	return op
+}
+
+// List the media types of a request or response body with their schemas
+func docsContentFor(content openapi3.Content) []docsContent {
+	var contents []docsContent
+	for contentType, mediaType := range content {
+		contents = append(contents, docsContent{Type: contentType, Schema: schemaJSON(mediaType.Schema)})
+	}
+	sort.Slice(contents, func(i, j int) bool {
+		return contents[i].Type < contents[j].Type
+	})
+	return contents
+}
+
+// Show a schema as indented JSON, leaving references to named schemas as
+// $ref so the page does not repeat them
+func schemaJSON(schema *openapi3.SchemaRef) string {
+	if schema == nil {
+		return ""
+	}
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOpenAPIDocument(t *testing.T) {
+	router := setupRouter()
+
+	req, err := http.NewRequest("GET", "/openapi.yaml", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	assert.Equal(t, "application/yaml", rr.Header().Get("Content-Type"))
+	assert.Equal(t, string(openAPIDocument), rr.Body.String())
+}
+
+func TestGetAPIDocs(t *testing.T) {
+	router := setupRouter()
+
+	req, err := http.NewRequest("GET", "/docs", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	body := rr.Body.String()
+	assert.Contains(t, body, `url: "/openapi.yaml"`)
+	assert.NotContains(t, body, "https://")
+	// Every asset the explorer loads is built into the server
+	for _, match := range regexp.MustCompile(`/docs/assets/([^"]+)`).FindAllStringSubmatch(body, -1) {
+		assert.True(t, api.GetAPIDocsAssetParamsFile(match[1]).Valid(), "Expected %s to be served", match[1])
+	}
+}
+
+func TestGetAPIDocsAsset(t *testing.T) {
+	router := setupRouter()
+
+	for _, file := range []api.GetAPIDocsAssetParamsFile{api.SwaggerUiCss, api.SwaggerUiBundleJs, api.Favicon16x16Png, api.Favicon32x32Png} {
+		req, err := http.NewRequest("GET", "/docs/assets/"+string(file), nil)
+		if err != nil {
+			t.Fatalf("could not create request: %v", err)
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200 for %s", file)
+		assert.NotEmpty(t, rr.Body.Bytes(), file)
+	}
+
+	// Other files of the Swagger UI bundle are not served
+	req, err := http.NewRequest("GET", "/docs/assets/index.html", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusNotFound, rr.Code, "Expected status code 404")
+	assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+}
+
+func TestGetAPIReference(t *testing.T) {
+	router := setupRouter()
+
+	req, err := http.NewRequest("GET", "/docs/reference", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	body := rr.Body.String()
+	// Every operation and schema is listed, and nothing is loaded from elsewhere
+	for _, operation := range apiDocsPage.Operations {
+		assert.Contains(t, body, `id="`+operation.ID+`"`)
+	}
+	assert.Contains(t, body, `id="schema-Problem"`)
+	assert.Contains(t, body, `<details id="createParticipantAvailability" class="deprecated">`)
+	assert.NotContains(t, body, "<script")
+	assert.NotContains(t, body, "https://")
+}
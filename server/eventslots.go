@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// Event Slots Handler
+func listEventSlots(w http.ResponseWriter, r *http.Request, eventID string) {
+	slots, err := schedule.ListEventSlots(eventID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	// Return the event's candidate slots
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(apiSlots(slots))
+}
+
+// Add Event Slot Handler
+func addEventSlot(w http.ResponseWriter, r *http.Request, eventID string) {
+	// Parse the request body to get the new slot
+	var body api.AddEventSlotJSONRequestBody
+	fieldErrors, err := decodeJSON(r.Body, &body)
+	if err != nil {
+		writeProblem(w, problemInvalidInput, "")
+		return
+	}
+	slot := slotFromAPI(body)
+	// Report unknown fields along with every other invalid field
+	if len(fieldErrors) > 0 {
+		writeProblem(w, problemValidation, "", append(fieldErrors, validateSlot("", slot)...)...)
+		return
+	}
+	slot, err = schedule.AddEventSlot(eventID, slot)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	// Return the new slot
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/v1/events/"+eventID+"/slots/"+slot.ID)
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(apiSlot(slot))
+}
+
+// Delete Event Slot Handler
+func deleteEventSlot(w http.ResponseWriter, r *http.Request, eventID string, slotID string) {
+	if err := schedule.DeleteEventSlot(eventID, slotID); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Give every slot of the event an ID. Slots keeping the ID of one of the
+// previous slots keep it, and slots sent without a known ID take the ID of a
+// previous slot with the same start and end time, so a finalized slot keeps
+// pointing at its candidate; the rest get a new ID that has never been used
+// for the event.
+func assignSlotIDs(event *Event, previousSlots []Slot) {
+	known := map[string]bool{}
+	for _, slot := range previousSlots {
+		known[slot.ID] = true
+		// Events stored before slot IDs were tracked start after their highest ID
+		if n, err := strconv.Atoi(slot.ID); err == nil && n > event.lastSlotID {
+			event.lastSlotID = n
+		}
+	}
+	used := map[string]bool{}
+	for i := range event.Slots {
+		slot := &event.Slots[i]
+		slot.SlotIDs = nil
+		if slot.ID != "" && known[slot.ID] && !used[slot.ID] {
+			used[slot.ID] = true
+		} else {
+			slot.ID = ""
+		}
+	}
+	for i := range event.Slots {
+		slot := &event.Slots[i]
+		if slot.ID != "" {
+			continue
+		}
+		for _, previous := range previousSlots {
+			if previous.ID != "" && !used[previous.ID] && previous.StartTime.Equal(slot.StartTime) && previous.EndTime.Equal(slot.EndTime) {
+				slot.ID = previous.ID
+				break
+			}
+		}
+		if slot.ID == "" {
+			event.lastSlotID++
+			slot.ID = strconv.Itoa(event.lastSlotID)
+		}
+		used[slot.ID] = true
+	}
+}
+
+// Point each slot at the IDs of the event slots it overlaps. The event's slots
+// and each recurring slot are expanded once, rather than once per pair.
+func tagSlotIDs(slots []Slot, event Event) {
+	candidates := eventSlots(event)
+	horizon := eventHorizon(event)
+	for i := range slots {
+		slots[i].SlotIDs = overlappingSlotIDs(expandSlots([]Slot{slots[i]}, horizon), candidates)
+	}
+}
+
+// Get the IDs of the event slots any of the occurrences overlaps
+func overlappingSlotIDs(occurrences []Slot, candidates []Slot) []string {
+	var ids []string
+	for _, eventSlot := range candidates {
+		if eventSlot.ID == "" || containsString(ids, eventSlot.ID) {
+			continue
+		}
+		for _, occurrence := range occurrences {
+			if occurrence.StartTime.Before(eventSlot.EndTime) && occurrence.EndTime.After(eventSlot.StartTime) {
+				ids = append(ids, eventSlot.ID)
+				break
+			}
+		}
+	}
+	return ids
+}
+
+// Refresh the event slot IDs on every participant's availability for the
+// event after its slots change. The caller must hold mu.
+func tagEventAvailability(eventID string) {
+	event := events[eventID]
+	for participantID := range participants {
+		for i, participant := range participants[participantID] {
+			if participant.EventID != eventID {
+				continue
+			}
+			// Tag copies, as availability handed out earlier shares the old lists
+			tagged := make([]Slot, len(participant.Availability))
+			copy(tagged, participant.Availability)
+			tagSlotIDs(tagged, event)
+			updated := append([]Participant(nil), participants[participantID]...)
+			updated[i].Availability = tagged
+			participants[participantID] = updated
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSlots(t *testing.T) {
+	router := setupRouter()
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["slots"] = Event{
+		ID:            "slots",
+		Title:         "Test Event",
+		Slots:         []Slot{{ID: "1", StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"s1"},
+	}
+	participants["s1"] = []Participant{{ID: "s1", EventID: "slots", Availability: []Slot{
+		{StartTime: start.Add(24 * time.Hour), EndTime: start.Add(25 * time.Hour)},
+	}}}
+
+	// Add a slot the day after
+	slotJSON, _ := json.Marshal(Slot{StartTime: start.Add(24 * time.Hour), EndTime: start.Add(25 * time.Hour)})
+	req, err := http.NewRequest("POST", "/event/slots/slots", bytes.NewBuffer(slotJSON))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code, "Expected status code 201")
+	var added Slot
+	if err := json.NewDecoder(rr.Body).Decode(&added); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	assert.Equal(t, "2", added.ID)
+	assert.Equal(t, "/v1/events/slots/slots/2", rr.Header().Get("Location"))
+	assert.Equal(t, []string{"2"}, participants["s1"][0].Availability[0].SlotIDs, "Availability should reference the new slot")
+
+	// Recommendations reference the slot IDs
+	req, _ = http.NewRequest("GET", "/event/slots/find-common-slots", nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	var response AvailabilityResponse
+	json.NewDecoder(rr.Body).Decode(&response)
+	assert.Equal(t, "2", response.RecommendedTimeSlots[0].Slot.ID)
+
+	// Delete the first slot; the second keeps its ID
+	req, _ = http.NewRequest("DELETE", "/event/slots/slots/1", nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusNoContent, rr.Code, "Expected status code 204")
+
+	req, _ = http.NewRequest("GET", "/event/slots/slots", nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	var slots []Slot
+	json.NewDecoder(rr.Body).Decode(&slots)
+	assert.Len(t, slots, 1)
+	assert.Equal(t, "2", slots[0].ID)
+
+	// The last slot cannot be deleted, and deleted IDs are gone
+	req, _ = http.NewRequest("DELETE", "/event/slots/slots/2", nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, "Expected status code 422")
+	req, _ = http.NewRequest("DELETE", "/event/slots/slots/1", nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusNotFound, rr.Code, "Expected status code 404")
+}
+
+func TestAssignSlotIDs(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	event := Event{Slots: []Slot{
+		{StartTime: start, EndTime: start.Add(time.Hour)},
+		{StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour)},
+	}}
+	assignSlotIDs(&event, nil)
+	assert.Equal(t, "1", event.Slots[0].ID)
+	assert.Equal(t, "2", event.Slots[1].ID)
+
+	// Replacing the slots keeps known IDs and never reuses a dropped one
+	previous := event.Slots
+	event.Slots = []Slot{
+		{ID: "2", StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour)},
+		{ID: "2", StartTime: start.Add(3 * time.Hour), EndTime: start.Add(4 * time.Hour)},
+		{ID: "9", StartTime: start.Add(5 * time.Hour), EndTime: start.Add(6 * time.Hour)},
+	}
+	assignSlotIDs(&event, previous)
+	assert.Equal(t, []string{"2", "3", "4"}, []string{event.Slots[0].ID, event.Slots[1].ID, event.Slots[2].ID})
+
+	// Slots sent without IDs keep the ID of the slot at the same time
+	previous = event.Slots
+	event.Slots = []Slot{
+		{StartTime: start.Add(7 * time.Hour), EndTime: start.Add(8 * time.Hour)},
+		{StartTime: start.Add(3 * time.Hour), EndTime: start.Add(4 * time.Hour)},
+		{ID: "2", StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour)},
+	}
+	assignSlotIDs(&event, previous)
+	assert.Equal(t, []string{"5", "3", "2"}, []string{event.Slots[0].ID, event.Slots[1].ID, event.Slots[2].ID})
+}
+
+func TestReplaceEventKeepsFinalizedSlotID(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	slots := []Slot{
+		{StartTime: start, EndTime: start.Add(time.Hour)},
+		{StartTime: start.Add(24 * time.Hour), EndTime: start.Add(25 * time.Hour)},
+	}
+	event, err := schedule.CreateEvent(Event{Title: "Replaced", Slots: slots, EstimatedTime: time.Hour})
+	require.NoError(t, err)
+	finalized, err := schedule.FinalizeEvent(event.ID, Slot{ID: event.Slots[1].ID})
+	require.NoError(t, err)
+
+	// Sending the slots back without their IDs keeps them
+	replacement := finalized
+	replacement.Slots = []Slot{
+		{StartTime: slots[1].StartTime, EndTime: slots[1].EndTime},
+		{StartTime: slots[0].StartTime, EndTime: slots[0].EndTime},
+	}
+	replaced, err := schedule.UpdateEvent(event.ID, replacement)
+	require.NoError(t, err)
+	assert.Equal(t, event.Slots[1].ID, replaced.Slots[0].ID)
+	assert.Equal(t, event.Slots[0].ID, replaced.Slots[1].ID)
+	if assert.NotNil(t, replaced.FinalizedSlot) {
+		assert.Equal(t, replaced.Slots[0].ID, replaced.FinalizedSlot.ID)
+	}
+}
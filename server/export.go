@@ -0,0 +1,163 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// Rows written between flushes while streaming an export
+const exportFlushRows = 100
+
+// eventExport is a snapshot of everything exported for an event
+type eventExport struct {
+	Event        Event
+	Participants []Participant
+	Recommended  AvailabilityResponse
+}
+
+// Event Export Handler
+func exportEvent(w http.ResponseWriter, r *http.Request, eventID string, params api.ExportEventParams) {
+	format := params.Format
+	if format == "" {
+		format = api.Json
+	}
+	if !format.Valid() {
+		writeProblem(w, problemInvalidInput, "", FieldError{Field: "format", Message: "format must be csv or json"})
+		return
+	}
+
+	// Take a snapshot so the store is not locked while a slow client reads the export
+	mu.Lock()
+	export, exists := snapshotEventExport(eventID)
+	mu.Unlock()
+	// If the event does not exist, return a 404 error
+	if !exists {
+		writeProblem(w, problemEventNotFound, "")
+		return
+	}
+
+	if format == api.Csv {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"event-%s.csv\"", eventID))
+		w.WriteHeader(http.StatusOK)
+		writeEventExportCSV(w, export)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"event-%s.json\"", eventID))
+	w.WriteHeader(http.StatusOK)
+	writeEventExportJSON(w, export)
+}
+
+// Copy the event, its participants' availability and the current
+// find-common-slots results. The caller must hold mu.
+func snapshotEventExport(eventID string) (eventExport, bool) {
+	event, exists := events[eventID]
+	if !exists {
+		return eventExport{}, false
+	}
+	export := eventExport{Event: event, Recommended: commonSlots(eventID, event)}
+	var participantIDs []string
+	for participantID := range participants {
+		participantIDs = append(participantIDs, participantID)
+	}
+	sort.Strings(participantIDs)
+	for _, participantID := range participantIDs {
+		for _, participant := range participants[participantID] {
+			if participant.EventID == eventID {
+				participant.Availability = append([]Slot(nil), participant.Availability...)
+				export.Participants = append(export.Participants, participant)
+			}
+		}
+	}
+	return export, true
+}
+
+// Stream the export as one CSV table, one row per event slot, participant
+// availability slot, recommended slot and, for series, occurrence of each
+// candidate slot
+func writeEventExportCSV(w io.Writer, export eventExport) {
+	writer := csv.NewWriter(w)
+	rows := 0
+	write := func(record []string) {
+		writer.Write(record)
+		rows++
+		if rows%exportFlushRows == 0 {
+			writer.Flush()
+			flush(w)
+		}
+	}
+	formatTime := func(t time.Time) string { return t.Format(time.RFC3339) }
+
+	eventID := export.Event.ID
+	write([]string{"record", "event_id", "title", "participant_id", "start_time", "end_time", "rrule", "preference", "unavailable_participants", "candidate_start_time"})
+	write([]string{"event", eventID, csvText(export.Event.Title), "", "", "", "", "", "", ""})
+	for _, slot := range export.Event.Slots {
+		write([]string{"event_slot", eventID, "", "", formatTime(slot.StartTime), formatTime(slot.EndTime), slot.RRule, "", "", ""})
+	}
+	for _, participant := range export.Participants {
+		for _, slot := range participant.Availability {
+			write([]string{"availability", eventID, "", csvText(participant.ID), formatTime(slot.StartTime), formatTime(slot.EndTime), slot.RRule, slot.Preference, "", ""})
+		}
+	}
+	for _, recommended := range export.Recommended.RecommendedTimeSlots {
+		write([]string{"recommendation", eventID, "", "", formatTime(recommended.Slot.StartTime), formatTime(recommended.Slot.EndTime), "", "", csvText(strings.Join(recommended.UnavailableParticipants, ";")), ""})
+	}
+	// Occurrences name the candidate slot of the series they belong to
+	for _, series := range export.Recommended.SeriesRecommendations {
+		for _, occurrence := range series.Occurrences {
+			write([]string{"series_occurrence", eventID, "", "", formatTime(occurrence.Slot.StartTime), formatTime(occurrence.Slot.EndTime), "", "", csvText(strings.Join(occurrence.UnavailableParticipants, ";")), formatTime(series.Slot.StartTime)})
+		}
+	}
+	writer.Flush()
+}
+
+// Escape text a user entered so a spreadsheet does not run it as a formula,
+// by prefixing a quote to values starting with a formula character
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// Stream the export as an api.EventExport document, writing participants
+// one at a time
+func writeEventExportJSON(w io.Writer, export eventExport) {
+	encoder := json.NewEncoder(w)
+	recommended := apiAvailabilityResponse(export.Recommended)
+	io.WriteString(w, `{"event":`)
+	encoder.Encode(apiEvent(export.Event))
+	io.WriteString(w, `,"participants":[`)
+	for i, participant := range export.Participants {
+		if i > 0 {
+			io.WriteString(w, ",")
+		}
+		encoder.Encode(apiParticipant(participant))
+		if (i+1)%exportFlushRows == 0 {
+			flush(w)
+		}
+	}
+	io.WriteString(w, `],"recommendedTimeSlots":`)
+	encoder.Encode(recommended.RecommendedTimeSlots)
+	if recommended.SeriesRecommendations != nil {
+		io.WriteString(w, `,"seriesRecommendations":`)
+		encoder.Encode(recommended.SeriesRecommendations)
+	}
+	io.WriteString(w, "}\n")
+}
+
+// Push buffered output to the client if the writer supports it
+func flush(w io.Writer) {
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupExportEvent() Slot {
+	slot := Slot{StartTime: time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 13, 15, 0, 0, 0, time.UTC)}
+	events["export"] = Event{
+		ID:            "export",
+		Title:         "Test Event",
+		Slots:         []Slot{slot},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"ex1", "ex2"},
+	}
+	participants["ex1"] = []Participant{{ID: "ex1", EventID: "export", Availability: []Slot{slot}}}
+	participants["ex2"] = []Participant{{ID: "ex2", EventID: "export"}}
+	return slot
+}
+
+func TestExportEventJSON(t *testing.T) {
+	router := setupRouter()
+	slot := setupExportEvent()
+
+	req, err := http.NewRequest("GET", "/event/export/export?format=json", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	var response struct {
+		Event                Event             `json:"event"`
+		Participants         []Participant     `json:"participants"`
+		RecommendedTimeSlots []SlotUnavailable `json:"recommendedTimeSlots"`
+	}
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	assert.Equal(t, "Test Event", response.Event.Title)
+	assert.Len(t, response.Participants, 2)
+	if assert.Len(t, response.RecommendedTimeSlots, 1) {
+		assert.True(t, response.RecommendedTimeSlots[0].Slot.StartTime.Equal(slot.StartTime))
+		assert.Equal(t, []string{"ex2"}, response.RecommendedTimeSlots[0].UnavailableParticipants)
+	}
+}
+
+func TestExportEventCSV(t *testing.T) {
+	router := setupRouter()
+	setupExportEvent()
+
+	req, err := http.NewRequest("GET", "/event/export/export?format=csv", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	records, err := csv.NewReader(rr.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("could not read CSV: %v", err)
+	}
+	var kinds []string
+	for _, record := range records[1:] {
+		kinds = append(kinds, record[0])
+	}
+	assert.Equal(t, []string{"event", "event_slot", "availability", "recommendation"}, kinds)
+	assert.Equal(t, "ex2", records[len(records)-1][8])
+}
+
+func TestExportEventSeries(t *testing.T) {
+	router := setupRouter()
+	slot := setupExportEvent()
+	event := events["export"]
+	event.Series = &SeriesOptions{IntervalWeeks: 1, Occurrences: 2}
+	events["export"] = event
+
+	get := func(path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", path, nil)
+		if err != nil {
+			t.Fatalf("could not create request: %v", err)
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+		return rr
+	}
+
+	// The export carries the same series results as find-common-slots
+	var common, export AvailabilityResponse
+	if err := json.NewDecoder(get("/event/export/find-common-slots").Body).Decode(&common); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	if err := json.NewDecoder(get("/event/export/export?format=json").Body).Decode(&export); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	if assert.Len(t, export.SeriesRecommendations, 1) {
+		assert.Equal(t, common.SeriesRecommendations, export.SeriesRecommendations)
+		assert.True(t, export.SeriesRecommendations[0].Slot.StartTime.Equal(slot.StartTime))
+	}
+
+	records, err := csv.NewReader(get("/event/export/export?format=csv").Body).ReadAll()
+	if err != nil {
+		t.Fatalf("could not read CSV: %v", err)
+	}
+	var occurrences int
+	for _, record := range records[1:] {
+		if record[0] == "series_occurrence" {
+			occurrences++
+			assert.Equal(t, "2025-01-13T14:00:00Z", record[9], "Occurrences should name their candidate slot")
+		}
+	}
+	assert.Equal(t, 2, occurrences)
+}
+
+// Text users entered cannot run as a spreadsheet formula
+func TestExportEventCSVEscapesFormulas(t *testing.T) {
+	slot := Slot{StartTime: time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 13, 15, 0, 0, 0, time.UTC)}
+	events["export-formula"] = Event{
+		ID:            "export-formula",
+		Title:         "=HYPERLINK(\"http://example.com\")",
+		Slots:         []Slot{slot},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"@fx1", "-fx2"},
+	}
+	participants["@fx1"] = []Participant{{ID: "@fx1", EventID: "export-formula", Availability: []Slot{slot}}}
+	delete(participants, "-fx2")
+
+	req, err := http.NewRequest("GET", "/v1/events/export-formula/export?format=csv", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	setupRouter().ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	records, err := csv.NewReader(rr.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("could not read CSV: %v", err)
+	}
+	assert.Equal(t, `'=HYPERLINK("http://example.com")`, records[1][2])
+	assert.Equal(t, "'@fx1", records[3][3])
+	assert.Equal(t, "'-fx2", records[4][8])
+}
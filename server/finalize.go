@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// Notifier delivers messages about an event to its organizer
+type Notifier interface {
+	Notify(eventID string, message string)
+}
+
+type logNotifier struct{}
+
+func (logNotifier) Notify(eventID string, message string) {
+	log.Printf("Event %s: %s", eventID, message)
+}
+
+// notifier is used to report changes that need the organizer's attention
+var notifier Notifier = logNotifier{}
+
+// Finalize Event Handler
+func finalizeEvent(w http.ResponseWriter, r *http.Request, eventID string) {
+	// Parse the request body to get the chosen slot
+	var body api.FinalizeEventJSONRequestBody
+	fieldErrors, err := decodeJSON(r.Body, &body)
+	if err != nil {
+		writeProblem(w, problemInvalidInput, "")
+		return
+	}
+	if len(fieldErrors) > 0 {
+		writeProblem(w, problemValidation, "", fieldErrors...)
+		return
+	}
+	slot := Slot{
+		ID:        body.Id,
+		StartTime: body.StartTime,
+		EndTime:   body.EndTime,
+		RRule:     body.Rrule,
+		Zone:      body.Zone,
+		ExDates:   body.Exdates,
+	}
+	event, err := schedule.FinalizeEvent(eventID, slot)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	// Return the finalized event
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(apiEvent(event))
+}
+
+// Helper function to find a slot in a list of slots by its start and end
+// time, or by ID when no times are given
+func findSlot(slots []Slot, slot Slot) (Slot, bool) {
+	for _, s := range slots {
+		if slot.StartTime.IsZero() && slot.EndTime.IsZero() {
+			if slot.ID != "" && s.ID == slot.ID {
+				return s, true
+			}
+			continue
+		}
+		if s.StartTime.Equal(slot.StartTime) && s.EndTime.Equal(slot.EndTime) {
+			return s, true
+		}
+	}
+	return Slot{}, false
+}
+
+// Check the event's finalized slot against its participants' current
+// availability, flagging the event as at risk and notifying the organizer when
+// a participant can no longer attend. availability is the participants'
+// expanded availability from eventAvailability, or nil to expand it here if
+// the event has a finalized slot. The caller must hold mu.
+func revalidateFinalizedSlot(eventID string, availability map[string]ParticipantAvailability) {
+	event, exists := events[eventID]
+	if !exists || event.FinalizedSlot == nil {
+		return
+	}
+	if availability == nil {
+		availability = eventAvailability(eventID, event)
+	}
+	var conflicts []string
+	var newConflicts []string
+	for _, participantID := range event.Participants {
+		if attendsSlot(*event.FinalizedSlot, event.EstimatedTime, availability[participantID]) {
+			continue
+		}
+		conflicts = append(conflicts, participantID)
+		if !containsString(event.Conflicts, participantID) {
+			newConflicts = append(newConflicts, participantID)
+		}
+	}
+	event.Conflicts = conflicts
+	event.AtRisk = len(conflicts) > 0
+	events[eventID] = event
+	if len(newConflicts) > 0 {
+		notifier.Notify(eventID, fmt.Sprintf("finalized slot no longer works for: %s", strings.Join(newConflicts, ", ")))
+	}
+}
+
+// Helper function to check if a participant can attend a meeting in the slot:
+// they must be free for a continuous part of it as long as the meeting, or for
+// the whole slot if the meeting does not fit in it. Recommendations, the
+// deadline's auto-finalize and the finalized slot check all use this. The
+// availability must be merged, as eventAvailability returns it, so the blocks
+// that overlap the slot can be found by binary search.
+func attendsSlot(slot Slot, length time.Duration, participantAvailability ParticipantAvailability) bool {
+	if length <= 0 || length > slot.EndTime.Sub(slot.StartTime) {
+		length = slot.EndTime.Sub(slot.StartTime)
+	}
+	merged := participantAvailability.Slots
+	first := sort.Search(len(merged), func(i int) bool { return merged[i].EndTime.After(slot.StartTime) })
+	for _, free := range merged[first:] {
+		if !free.StartTime.Before(slot.EndTime) {
+			break
+		}
+		start, end := free.StartTime, free.EndTime
+		if start.Before(slot.StartTime) {
+			start = slot.StartTime
+		}
+		if end.After(slot.EndTime) {
+			end = slot.EndTime
+		}
+		if end.Sub(start) >= length {
+			return true
+		}
+	}
+	return false
+}
+
+// Helper function to check if a string is in a list
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
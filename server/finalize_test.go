@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingNotifier keeps every notification it is sent
+type recordingNotifier struct {
+	messages []string
+}
+
+func (n *recordingNotifier) Notify(eventID string, message string) {
+	n.messages = append(n.messages, eventID+": "+message)
+}
+
+func TestFinalizedEventAtRiskAfterAvailabilityChange(t *testing.T) {
+	router := setupRouter()
+	rn := &recordingNotifier{}
+	notifier = rn
+	t.Cleanup(func() { notifier = logNotifier{} })
+
+	start := time.Date(2025, 1, 12, 14, 0, 0, 0, time.UTC)
+	slot := Slot{StartTime: start, EndTime: start.Add(time.Hour)}
+	events["risk"] = Event{
+		ID:            "risk",
+		Title:         "Test Event",
+		Slots:         []Slot{slot},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"r1"},
+		Status:        EventStatusOpen,
+	}
+	participants["r1"] = []Participant{{ID: "r1", EventID: "risk", Availability: []Slot{slot}}}
+
+	// Finalize the only slot
+	slotJSON, _ := json.Marshal(slot)
+	req, err := http.NewRequest("POST", "/event/risk/finalize", bytes.NewBuffer(slotJSON))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	assert.False(t, events["risk"].AtRisk, "Event should not be at risk yet")
+
+	// Move the participant's availability away from the finalized slot
+	updateJSON, _ := json.Marshal(map[string]interface{}{
+		"event_id": "risk",
+		"slots":    []Slot{{StartTime: start.Add(24 * time.Hour), EndTime: start.Add(25 * time.Hour)}},
+	})
+	req, err = http.NewRequest("PUT", "/participant/r1", bytes.NewBuffer(updateJSON))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+
+	// The conflict shows up on GET /events/{id}
+	req, err = http.NewRequest("GET", "/events/risk", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	var response Event
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	assert.True(t, response.AtRisk, "Event should be at risk")
+	assert.Equal(t, []string{"r1"}, response.Conflicts)
+	assert.Len(t, rn.messages, 1, "Organizer should be notified once")
+}
+
+func TestFinalizedEventAtRiskAfterPartialAvailability(t *testing.T) {
+	rn := &recordingNotifier{}
+	notifier = rn
+	t.Cleanup(func() { notifier = logNotifier{} })
+
+	start := time.Date(2025, 1, 12, 14, 0, 0, 0, time.UTC)
+	slot := Slot{ID: "1", StartTime: start, EndTime: start.Add(2 * time.Hour)}
+	events["partial"] = Event{
+		ID:            "partial",
+		Title:         "Test Event",
+		Slots:         []Slot{slot},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"p1"},
+		Status:        EventStatusOpen,
+		lastSlotID:    1,
+	}
+	participants["p1"] = []Participant{{ID: "p1", EventID: "partial", Availability: []Slot{slot}}}
+	_, err := schedule.FinalizeEvent("partial", Slot{ID: "1"})
+	assert.NoError(t, err)
+
+	// An hour of the two hour slot is still enough for the meeting
+	_, err = schedule.ReplaceParticipantSlots("partial", "p1", []Slot{{StartTime: start.Add(30 * time.Minute), EndTime: start.Add(90 * time.Minute)}})
+	assert.NoError(t, err)
+	assert.False(t, events["partial"].AtRisk, "Event should not be at risk yet")
+
+	// Two half hours that overlap the slot are not
+	_, err = schedule.ReplaceParticipantSlots("partial", "p1", []Slot{
+		{StartTime: start.Add(-30 * time.Minute), EndTime: start.Add(30 * time.Minute)},
+		{StartTime: start.Add(90 * time.Minute), EndTime: start.Add(150 * time.Minute)},
+	})
+	assert.NoError(t, err)
+	assert.True(t, events["partial"].AtRisk, "Event should be at risk")
+	assert.Equal(t, []string{"p1"}, events["partial"].Conflicts)
+	assert.Len(t, rn.messages, 1, "Organizer should be notified once")
+}
+
+func TestFinalizeEventUnknownField(t *testing.T) {
+	start := time.Date(2025, 1, 12, 14, 0, 0, 0, time.UTC)
+	events["finalize-unknown"] = Event{
+		ID:            "finalize-unknown",
+		Title:         "Test Event",
+		Slots:         []Slot{{ID: "1", StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+	}
+	req, err := http.NewRequest("POST", "/v1/events/finalize-unknown/finalize", bytes.NewBufferString(`{"id": "1", "slot_id": "1"}`))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	setupRouter().ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, "Expected status code 422")
+	var problem Problem
+	if err := json.NewDecoder(rr.Body).Decode(&problem); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	assert.Equal(t, []FieldError{{Field: "slot_id", Message: "unknown field"}}, problem.Errors)
+	assert.Nil(t, events["finalize-unknown"].FinalizedSlot)
+}
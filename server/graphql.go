@@ -0,0 +1,614 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var graphqlSchemaSource string
+
+// Parsed once at startup, like the page templates. Queries are limited in
+// depth because events, participants and their events can nest forever.
+var graphqlSchema = graphql.MustParseSchema(graphqlSchemaSource, &graphqlResolver{}, graphql.MaxDepth(10))
+
+// GraphQL Handler
+func serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	// Parse the request body to get the query and its variables
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		writeProblem(w, problemInvalidInput, "")
+		return
+	}
+	// Errors in the query or from the scheduler are reported in the response
+	response := graphqlSchema.Exec(r.Context(), params.Query, params.OperationName, params.Variables)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// Extensions adds the problem details to the GraphQL error for a scheduler
+// error, so clients can tell problems apart the same way as with REST
+func (e *problemError) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{
+		"type":   e.problem.Type,
+		"status": e.problem.Status,
+	}
+	if len(e.fieldErrors) > 0 {
+		extensions["errors"] = e.fieldErrors
+	}
+	return extensions
+}
+
+// Check whether an error is a particular problem
+func isProblem(err error, problem problemType) bool {
+	var p *problemError
+	return errors.As(err, &p) && p.problem == problem
+}
+
+// graphqlResolver resolves the queries and mutations on top of the scheduler
+// the HTTP handlers use
+type graphqlResolver struct{}
+
+func (graphqlResolver) Event(args struct{ ID graphql.ID }) (*eventResolver, error) {
+	event, err := schedule.GetEvent(string(args.ID))
+	if isProblem(err, problemEventNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &eventResolver{event}, nil
+}
+
+func (graphqlResolver) Events() []*eventResolver {
+	resolvers := []*eventResolver{}
+	for _, event := range schedule.ListEvents() {
+		resolvers = append(resolvers, &eventResolver{event})
+	}
+	return resolvers
+}
+
+func (graphqlResolver) Participant(args struct{ ID graphql.ID }) (*participantResolver, error) {
+	responses, err := schedule.GetParticipant(string(args.ID))
+	if isProblem(err, problemParticipantNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &participantResolver{id: string(args.ID), responses: responses}, nil
+}
+
+func (graphqlResolver) CreateEvent(args struct{ Input eventInput }) (*eventResolver, error) {
+	input, err := args.Input.event()
+	if err != nil {
+		return nil, err
+	}
+	event, err := schedule.CreateEvent(input)
+	if err != nil {
+		return nil, err
+	}
+	return &eventResolver{event}, nil
+}
+
+func (graphqlResolver) UpdateEvent(args struct {
+	ID    graphql.ID
+	Input eventInput
+}) (*eventResolver, error) {
+	input, err := args.Input.event()
+	if err != nil {
+		return nil, err
+	}
+	event, err := schedule.UpdateEvent(string(args.ID), input)
+	if err != nil {
+		return nil, err
+	}
+	return &eventResolver{event}, nil
+}
+
+func (graphqlResolver) PatchEvent(args struct {
+	ID    graphql.ID
+	Patch jsonObject
+}) (*eventResolver, error) {
+	event, err := schedule.PatchEvent(string(args.ID), args.Patch)
+	if err != nil {
+		return nil, err
+	}
+	return &eventResolver{event}, nil
+}
+
+func (graphqlResolver) DeleteEvent(args struct{ ID graphql.ID }) (bool, error) {
+	if err := schedule.DeleteEvent(string(args.ID)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (graphqlResolver) FinalizeEvent(args struct {
+	ID   graphql.ID
+	Slot slotInput
+}) (*eventResolver, error) {
+	event, err := schedule.FinalizeEvent(string(args.ID), args.Slot.slot())
+	if err != nil {
+		return nil, err
+	}
+	return &eventResolver{event}, nil
+}
+
+func (graphqlResolver) AddEventSlot(args struct {
+	EventID graphql.ID
+	Slot    slotInput
+}) (*slotResolver, error) {
+	slot, err := schedule.AddEventSlot(string(args.EventID), args.Slot.slot())
+	if err != nil {
+		return nil, err
+	}
+	return &slotResolver{slot}, nil
+}
+
+func (graphqlResolver) DeleteEventSlot(args struct {
+	EventID graphql.ID
+	SlotID  graphql.ID
+}) (bool, error) {
+	if err := schedule.DeleteEventSlot(string(args.EventID), string(args.SlotID)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (graphqlResolver) ReplaceParticipantSlots(args struct {
+	EventID       graphql.ID
+	ParticipantID graphql.ID
+	Slots         []slotInput
+}) (*availabilityUpdateResolver, error) {
+	var slots []Slot
+	for _, slot := range args.Slots {
+		slots = append(slots, slot.slot())
+	}
+	update, err := schedule.ReplaceParticipantSlots(string(args.EventID), string(args.ParticipantID), slots)
+	if err != nil {
+		return nil, err
+	}
+	return &availabilityUpdateResolver{update}, nil
+}
+
+func (graphqlResolver) AddParticipantSlot(args struct {
+	EventID       graphql.ID
+	ParticipantID graphql.ID
+	Slot          slotInput
+}) (*availabilityUpdateResolver, error) {
+	update, err := schedule.AddParticipantSlot(string(args.EventID), string(args.ParticipantID), args.Slot.slot())
+	if err != nil {
+		return nil, err
+	}
+	return &availabilityUpdateResolver{update}, nil
+}
+
+func (graphqlResolver) RemoveParticipantSlots(args struct {
+	EventID       graphql.ID
+	ParticipantID graphql.ID
+	StartTime     graphql.Time
+	EndTime       graphql.Time
+}) (*availabilityUpdateResolver, error) {
+	block := Slot{StartTime: args.StartTime.Time, EndTime: args.EndTime.Time}
+	update, err := schedule.RemoveParticipantSlots(string(args.EventID), string(args.ParticipantID), block)
+	if err != nil {
+		return nil, err
+	}
+	return &availabilityUpdateResolver{update}, nil
+}
+
+func (graphqlResolver) DeleteParticipant(args struct {
+	EventID       graphql.ID
+	ParticipantID graphql.ID
+}) (bool, error) {
+	if err := schedule.DeleteParticipant(string(args.EventID), string(args.ParticipantID)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (graphqlResolver) ImportParticipants(args struct {
+	EventID graphql.ID
+	CSV     string
+}) (*availabilityImportResolver, error) {
+	if len(args.CSV) > maxCSVUpload {
+		return nil, newProblem(problemInvalidInput, "The CSV file is too large")
+	}
+	availability, err := readAvailabilityCSV(strings.NewReader(args.CSV))
+	if err != nil {
+		return nil, err
+	}
+	imported, err := schedule.ImportAvailability(string(args.EventID), availability)
+	if err != nil {
+		return nil, err
+	}
+	return &availabilityImportResolver{imported}, nil
+}
+
+// Inputs
+
+type eventInput struct {
+	Title            string
+	Slots            []slotInput
+	EstimatedTime    string
+	Participants     *[]graphql.ID
+	Organizer        *string
+	Horizon          *graphql.Time
+	Series           *seriesOptionsInput
+	ResponseDeadline *graphql.Time
+	AutoFinalize     *bool
+}
+
+type slotInput struct {
+	ID         *graphql.ID
+	StartTime  *graphql.Time
+	EndTime    *graphql.Time
+	RRule      *string
+	Zone       *string
+	ExDates    *[]graphql.Time
+	Preference *string
+}
+
+type seriesOptionsInput struct {
+	IntervalWeeks *int32
+	Occurrences   int32
+	Zone          *string
+}
+
+// jsonObject is a JSON scalar argument, which must be an object
+type jsonObject map[string]interface{}
+
+func (jsonObject) ImplementsGraphQLType(name string) bool { return name == "JSON" }
+
+func (o *jsonObject) UnmarshalGraphQL(input interface{}) error {
+	object, ok := input.(map[string]interface{})
+	if !ok {
+		return errors.New("JSON must be an object")
+	}
+	*o = object
+	return nil
+}
+
+// Turn the input into an event for the scheduler to validate
+func (input eventInput) event() (Event, error) {
+	estimatedTime, err := time.ParseDuration(input.EstimatedTime)
+	if err != nil {
+		return Event{}, newProblem(problemValidation, "", FieldError{Field: "estimatedTime", Message: "estimatedTime must be a duration like 1h30m"})
+	}
+	event := Event{
+		Title:         input.Title,
+		EstimatedTime: estimatedTime,
+		Organizer:     stringValue(input.Organizer),
+		AutoFinalize:  input.AutoFinalize != nil && *input.AutoFinalize,
+	}
+	for _, slot := range input.Slots {
+		event.Slots = append(event.Slots, slot.slot())
+	}
+	if input.Participants != nil {
+		for _, participantID := range *input.Participants {
+			event.Participants = append(event.Participants, string(participantID))
+		}
+	}
+	if input.Horizon != nil {
+		event.Horizon = &input.Horizon.Time
+	}
+	if input.ResponseDeadline != nil {
+		event.ResponseDeadline = &input.ResponseDeadline.Time
+	}
+	if input.Series != nil {
+		event.Series = &SeriesOptions{Occurrences: int(input.Series.Occurrences), Zone: stringValue(input.Series.Zone)}
+		if input.Series.IntervalWeeks != nil {
+			event.Series.IntervalWeeks = int(*input.Series.IntervalWeeks)
+		}
+	}
+	return event, nil
+}
+
+// Turn the input into a slot. Missing times are the zero time, so validation
+// reports them as required.
+func (input slotInput) slot() Slot {
+	slot := Slot{RRule: stringValue(input.RRule), Zone: stringValue(input.Zone), Preference: stringValue(input.Preference)}
+	if input.ID != nil {
+		slot.ID = string(*input.ID)
+	}
+	if input.StartTime != nil {
+		slot.StartTime = input.StartTime.Time
+	}
+	if input.EndTime != nil {
+		slot.EndTime = input.EndTime.Time
+	}
+	if input.ExDates != nil {
+		for _, exdate := range *input.ExDates {
+			slot.ExDates = append(slot.ExDates, exdate.Time)
+		}
+	}
+	return slot
+}
+
+// Results
+
+type eventResolver struct {
+	event Event
+}
+
+func (r *eventResolver) ID() graphql.ID         { return graphql.ID(r.event.ID) }
+func (r *eventResolver) Title() string          { return r.event.Title }
+func (r *eventResolver) Slots() []*slotResolver { return slotResolvers(r.event.Slots) }
+func (r *eventResolver) EstimatedTime() string  { return r.event.EstimatedTime.String() }
+func (r *eventResolver) Organizer() *string     { return optionalString(r.event.Organizer) }
+func (r *eventResolver) Horizon() *graphql.Time {
+	return optionalTime(r.event.Horizon)
+}
+func (r *eventResolver) ResponseDeadline() *graphql.Time {
+	return optionalTime(r.event.ResponseDeadline)
+}
+func (r *eventResolver) AutoFinalize() bool  { return r.event.AutoFinalize }
+func (r *eventResolver) Status() string      { return r.event.Status }
+func (r *eventResolver) AtRisk() bool        { return r.event.AtRisk }
+func (r *eventResolver) Conflicts() []string { return nonNilStrings(r.event.Conflicts) }
+
+func (r *eventResolver) Series() *seriesOptionsResolver {
+	if r.event.Series == nil {
+		return nil
+	}
+	return &seriesOptionsResolver{*r.event.Series}
+}
+
+func (r *eventResolver) FinalizedSlot() *slotResolver {
+	if r.event.FinalizedSlot == nil {
+		return nil
+	}
+	return &slotResolver{*r.event.FinalizedSlot}
+}
+
+func (r *eventResolver) Participants() ([]*eventParticipantResolver, error) {
+	list, err := schedule.EventParticipants(r.event.ID)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := []*eventParticipantResolver{}
+	for _, participant := range list {
+		resolvers = append(resolvers, &eventParticipantResolver{participant})
+	}
+	return resolvers, nil
+}
+
+func (r *eventResolver) Recommendations() (*recommendationsResolver, error) {
+	response, err := schedule.FindCommonSlots(r.event.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &recommendationsResolver{response}, nil
+}
+
+type eventParticipantResolver struct {
+	participant eventParticipant
+}
+
+func (r *eventParticipantResolver) ID() graphql.ID  { return graphql.ID(r.participant.ID) }
+func (r *eventParticipantResolver) Responded() bool { return r.participant.Responded }
+func (r *eventParticipantResolver) Availability() []*slotResolver {
+	return slotResolvers(r.participant.Availability)
+}
+
+type participantResolver struct {
+	id        string
+	responses []Participant
+}
+
+func (r *participantResolver) ID() graphql.ID { return graphql.ID(r.id) }
+
+// Events the participant responded to that have since been deleted are left out
+func (r *participantResolver) Events() ([]*participantEventResolver, error) {
+	resolvers := []*participantEventResolver{}
+	for _, participant := range r.responses {
+		event, err := schedule.GetEvent(participant.EventID)
+		if isProblem(err, problemEventNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		resolvers = append(resolvers, &participantEventResolver{event: event, availability: participant.Availability})
+	}
+	return resolvers, nil
+}
+
+type participantEventResolver struct {
+	event        Event
+	availability []Slot
+}
+
+func (r *participantEventResolver) Event() *eventResolver { return &eventResolver{r.event} }
+func (r *participantEventResolver) Availability() []*slotResolver {
+	return slotResolvers(r.availability)
+}
+
+type slotResolver struct {
+	slot Slot
+}
+
+func (r *slotResolver) ID() *graphql.ID {
+	if r.slot.ID == "" {
+		return nil
+	}
+	id := graphql.ID(r.slot.ID)
+	return &id
+}
+func (r *slotResolver) StartTime() graphql.Time { return graphql.Time{Time: r.slot.StartTime} }
+func (r *slotResolver) EndTime() graphql.Time   { return graphql.Time{Time: r.slot.EndTime} }
+func (r *slotResolver) RRule() *string          { return optionalString(r.slot.RRule) }
+func (r *slotResolver) Zone() *string           { return optionalString(r.slot.Zone) }
+func (r *slotResolver) Preference() *string     { return optionalString(r.slot.Preference) }
+
+func (r *slotResolver) ExDates() []graphql.Time {
+	exdates := []graphql.Time{}
+	for _, exdate := range r.slot.ExDates {
+		exdates = append(exdates, graphql.Time{Time: exdate})
+	}
+	return exdates
+}
+
+func (r *slotResolver) SlotIDs() []graphql.ID {
+	ids := []graphql.ID{}
+	for _, id := range r.slot.SlotIDs {
+		ids = append(ids, graphql.ID(id))
+	}
+	return ids
+}
+
+type seriesOptionsResolver struct {
+	series SeriesOptions
+}
+
+func (r *seriesOptionsResolver) IntervalWeeks() int32 { return int32(r.series.IntervalWeeks) }
+func (r *seriesOptionsResolver) Occurrences() int32   { return int32(r.series.Occurrences) }
+func (r *seriesOptionsResolver) Zone() *string        { return optionalString(r.series.Zone) }
+
+type recommendationsResolver struct {
+	response AvailabilityResponse
+}
+
+func (r *recommendationsResolver) RecommendedTimeSlots() []*slotRecommendationResolver {
+	return slotRecommendationResolvers(r.response.RecommendedTimeSlots)
+}
+
+func (r *recommendationsResolver) SeriesRecommendations() []*seriesRecommendationResolver {
+	resolvers := []*seriesRecommendationResolver{}
+	for _, recommendation := range r.response.SeriesRecommendations {
+		resolvers = append(resolvers, &seriesRecommendationResolver{recommendation})
+	}
+	return resolvers
+}
+
+type slotRecommendationResolver struct {
+	recommendation SlotUnavailable
+}
+
+func (r *slotRecommendationResolver) Slot() *slotResolver {
+	return &slotResolver{r.recommendation.Slot}
+}
+func (r *slotRecommendationResolver) UnavailableParticipants() []string {
+	return nonNilStrings(r.recommendation.UnavailableParticipants)
+}
+
+type seriesRecommendationResolver struct {
+	recommendation SeriesRecommendation
+}
+
+func (r *seriesRecommendationResolver) Slot() *slotResolver {
+	return &slotResolver{r.recommendation.Slot}
+}
+func (r *seriesRecommendationResolver) Score() int32 { return int32(r.recommendation.Score) }
+func (r *seriesRecommendationResolver) Occurrences() []*slotRecommendationResolver {
+	return slotRecommendationResolvers(r.recommendation.Occurrences)
+}
+
+// Attendance is listed by participant ID, since GraphQL has no map type
+func (r *seriesRecommendationResolver) Attendance() []*attendanceResolver {
+	resolvers := []*attendanceResolver{}
+	for participantID, occurrences := range r.recommendation.Attendance {
+		resolvers = append(resolvers, &attendanceResolver{participantID, occurrences})
+	}
+	sort.Slice(resolvers, func(i, j int) bool {
+		return resolvers[i].participantID < resolvers[j].participantID
+	})
+	return resolvers
+}
+
+type attendanceResolver struct {
+	participantID string
+	occurrences   int
+}
+
+func (r *attendanceResolver) ParticipantID() graphql.ID { return graphql.ID(r.participantID) }
+func (r *attendanceResolver) Occurrences() int32        { return int32(r.occurrences) }
+
+type availabilityUpdateResolver struct {
+	update availabilityUpdate
+}
+
+func (r *availabilityUpdateResolver) Availability() []*slotResolver {
+	return slotResolvers(r.update.Availability)
+}
+func (r *availabilityUpdateResolver) Normalized() *normalizationResolver {
+	return &normalizationResolver{r.update.Normalized}
+}
+func (r *availabilityUpdateResolver) Created() bool { return r.update.Created }
+
+type availabilityImportResolver struct {
+	imported availabilityImport
+}
+
+func (r *availabilityImportResolver) Participants() int32 { return int32(r.imported.Participants) }
+func (r *availabilityImportResolver) Slots() int32        { return int32(r.imported.Slots) }
+func (r *availabilityImportResolver) Normalized() *normalizationResolver {
+	return &normalizationResolver{r.imported.Normalized}
+}
+
+type normalizationResolver struct {
+	normalization AvailabilityNormalization
+}
+
+func (r *normalizationResolver) Merged() int32  { return int32(r.normalization.Merged) }
+func (r *normalizationResolver) Clipped() int32 { return int32(r.normalization.Clipped) }
+func (r *normalizationResolver) Dropped() int32 { return int32(r.normalization.Dropped) }
+
+// Helpers for GraphQL's nullable and non-null lists
+
+func slotResolvers(slots []Slot) []*slotResolver {
+	resolvers := []*slotResolver{}
+	for _, slot := range slots {
+		resolvers = append(resolvers, &slotResolver{slot})
+	}
+	return resolvers
+}
+
+func slotRecommendationResolvers(recommendations []SlotUnavailable) []*slotRecommendationResolver {
+	resolvers := []*slotRecommendationResolver{}
+	for _, recommendation := range recommendations {
+		resolvers = append(resolvers, &slotRecommendationResolver{recommendation})
+	}
+	return resolvers
+}
+
+func nonNilStrings(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func optionalTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
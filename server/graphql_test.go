@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Run a GraphQL request through the router and decode the response
+func postGraphQL(t *testing.T, query string, variables map[string]interface{}) (map[string]interface{}, []map[string]interface{}) {
+	body, _ := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	req, err := http.NewRequest("POST", "/graphql", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	setupRouter().ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+
+	var response struct {
+		Data   map[string]interface{}   `json:"data"`
+		Errors []map[string]interface{} `json:"errors"`
+	}
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	return response.Data, response.Errors
+}
+
+func TestGraphQLEventQuery(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["gql"] = Event{
+		ID:            "gql",
+		Title:         "GraphQL meeting",
+		Slots:         []Slot{{ID: "1", StartTime: start, EndTime: start.Add(time.Hour)}, {ID: "2", StartTime: start.Add(24 * time.Hour), EndTime: start.Add(25 * time.Hour)}},
+		EstimatedTime: time.Hour,
+		Participants:  []string{"q1", "q2"},
+	}
+	participants["q1"] = []Participant{{ID: "q1", EventID: "gql", Availability: []Slot{{StartTime: start, EndTime: start.Add(time.Hour), SlotIDs: []string{"1"}}}}}
+	delete(participants, "q2")
+
+	// The event, its participants' availability and the recommendations in one round trip
+	data, errs := postGraphQL(t, `query($id: ID!) {
+		event(id: $id) {
+			title
+			estimatedTime
+			participants { id responded availability { startTime slotIds } }
+			recommendations { recommendedTimeSlots { slot { id } unavailableParticipants } }
+		}
+		missing: event(id: "no-such-event") { id }
+	}`, map[string]interface{}{"id": "gql"})
+	require.Empty(t, errs)
+	assert.Nil(t, data["missing"], "A missing event is null")
+	event := data["event"].(map[string]interface{})
+	assert.Equal(t, "GraphQL meeting", event["title"])
+	assert.Equal(t, "1h0m0s", event["estimatedTime"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"id": "q1", "responded": true, "availability": []interface{}{
+			map[string]interface{}{"startTime": "2025-01-13T14:00:00Z", "slotIds": []interface{}{"1"}},
+		}},
+		map[string]interface{}{"id": "q2", "responded": false, "availability": []interface{}{}},
+	}, event["participants"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"slot": map[string]interface{}{"id": "1"}, "unavailableParticipants": []interface{}{"q2"}},
+	}, event["recommendations"].(map[string]interface{})["recommendedTimeSlots"])
+
+	data, errs = postGraphQL(t, `{ participant(id: "q1") { events { event { id } availability { endTime } } } }`, nil)
+	require.Empty(t, errs)
+	assert.Equal(t, map[string]interface{}{"events": []interface{}{
+		map[string]interface{}{"event": map[string]interface{}{"id": "gql"}, "availability": []interface{}{
+			map[string]interface{}{"endTime": "2025-01-13T15:00:00Z"},
+		}},
+	}}, data["participant"])
+}
+
+func TestGraphQLMutations(t *testing.T) {
+	delete(participants, "q3")
+
+	data, errs := postGraphQL(t, `mutation {
+		createEvent(input: {
+			title: "Created over GraphQL"
+			slots: [{startTime: "2025-01-13T14:00:00Z", endTime: "2025-01-13T16:00:00Z"}]
+			estimatedTime: "1h"
+			participants: ["q3"]
+		}) { id status slots { id } }
+	}`, nil)
+	require.Empty(t, errs)
+	created := data["createEvent"].(map[string]interface{})
+	assert.Equal(t, EventStatusOpen, created["status"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"id": "1"}}, created["slots"])
+	eventID := created["id"].(string)
+
+	// Availability stored over GraphQL is the same availability REST sees
+	data, errs = postGraphQL(t, `mutation($event: ID!) {
+		replaceParticipantSlots(eventId: $event, participantId: "q3", slots: [
+			{startTime: "2025-01-13T13:00:00Z", endTime: "2025-01-13T15:00:00Z"}
+		]) { created normalized { clipped } availability { startTime } }
+	}`, map[string]interface{}{"event": eventID})
+	require.Empty(t, errs)
+	assert.Equal(t, map[string]interface{}{
+		"created":      true,
+		"normalized":   map[string]interface{}{"clipped": float64(1)},
+		"availability": []interface{}{map[string]interface{}{"startTime": "2025-01-13T14:00:00Z"}},
+	}, data["replaceParticipantSlots"])
+	participant, err := schedule.ListParticipantSlots(eventID, "q3")
+	require.NoError(t, err)
+	assert.Len(t, participant.Availability, 1)
+
+	// A merge patch uses the REST field names, like PATCH /v1/events/{id}
+	data, errs = postGraphQL(t, `mutation($event: ID!, $patch: JSON!) {
+		patchEvent(id: $event, patch: $patch) { title estimatedTime participants { id } }
+	}`, map[string]interface{}{"event": eventID, "patch": map[string]interface{}{"title": "Patched over GraphQL", "estimatedTime": 1800000000000}})
+	require.Empty(t, errs)
+	assert.Equal(t, map[string]interface{}{
+		"title":         "Patched over GraphQL",
+		"estimatedTime": "30m0s",
+		"participants":  []interface{}{map[string]interface{}{"id": "q3"}},
+	}, data["patchEvent"])
+
+	// A CSV import is all or nothing, like POST /v1/events/{id}/participants/import
+	delete(participants, "q4")
+	data, errs = postGraphQL(t, `mutation($event: ID!, $csv: String!) {
+		importParticipants(eventId: $event, csv: $csv) { participants slots normalized { clipped } }
+	}`, map[string]interface{}{"event": eventID, "csv": "participant_id,start,end\nq4,2025-01-13T15:00:00Z,2025-01-13T17:00:00Z\n"})
+	require.Empty(t, errs)
+	assert.Equal(t, map[string]interface{}{
+		"participants": float64(1),
+		"slots":        float64(1),
+		"normalized":   map[string]interface{}{"clipped": float64(1)},
+	}, data["importParticipants"])
+	assert.Equal(t, []string{"q3", "q4"}, events[eventID].Participants)
+	_, errs = postGraphQL(t, `mutation($event: ID!, $csv: String!) {
+		importParticipants(eventId: $event, csv: $csv) { participants }
+	}`, map[string]interface{}{"event": eventID, "csv": "participant_id,start,end\nq5,2025-01-13T17:00:00Z,2025-01-13T15:00:00Z\n"})
+	if assert.Len(t, errs, 1) {
+		assert.Equal(t, problemValidation.Type, errs[0]["extensions"].(map[string]interface{})["type"])
+	}
+	_, found := participants["q5"]
+	assert.False(t, found)
+
+	// Scheduler problems come back as errors with the problem details
+	_, errs = postGraphQL(t, `mutation {
+		createEvent(input: {title: "", slots: [], estimatedTime: "1h"}) { id }
+	}`, nil)
+	if assert.Len(t, errs, 1) {
+		extensions := errs[0]["extensions"].(map[string]interface{})
+		assert.Equal(t, problemValidation.Type, extensions["type"])
+		assert.Equal(t, float64(422), extensions["status"])
+		assert.Len(t, extensions["errors"], 2)
+	}
+	_, errs = postGraphQL(t, `mutation { deleteEvent(id: "no-such-event") }`, nil)
+	if assert.Len(t, errs, 1) {
+		assert.Equal(t, problemEventNotFound.Type, errs[0]["extensions"].(map[string]interface{})["type"])
+	}
+}
+
+func TestGraphQLEventsOrderedByID(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	for i := 0; i < 11; i++ {
+		_, err := schedule.CreateEvent(Event{
+			Title:         "Listed event",
+			Slots:         []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}},
+			EstimatedTime: time.Hour,
+		})
+		require.NoError(t, err)
+	}
+	events["listed"] = Event{ID: "listed", Title: "Listed event"}
+
+	data, errs := postGraphQL(t, `{ events { id } }`, nil)
+	require.Empty(t, errs)
+	// Numeric IDs come in numeric order, so 10 follows 9 rather than 1, and
+	// IDs that are not numbers come last
+	var numbers []int
+	textual := false
+	for _, event := range data["events"].([]interface{}) {
+		id := event.(map[string]interface{})["id"].(string)
+		n, err := strconv.Atoi(id)
+		if err != nil {
+			textual = true
+			continue
+		}
+		assert.False(t, textual, "Expected %s before the IDs that are not numbers", id)
+		numbers = append(numbers, n)
+	}
+	assert.GreaterOrEqual(t, len(numbers), 11)
+	assert.True(t, sort.IntsAreSorted(numbers), "Expected numeric order, got %v", numbers)
+	assert.True(t, textual)
+}
@@ -0,0 +1,421 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net"
+	"time"
+
+	"github.com/deepakg86/go-event-scheduler/schedulerpb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Serve the gRPC API on its own port
+func serveGRPC(addr string) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println("gRPC server started at " + addr)
+	log.Fatal(newGRPCServer().Serve(listener))
+}
+
+// Create a gRPC server with every scheduler service registered. Reflection is
+// on so tools like grpcurl can list the services.
+func newGRPCServer() *grpc.Server {
+	server := grpc.NewServer()
+	schedulerpb.RegisterEventServiceServer(server, eventServer{})
+	schedulerpb.RegisterAvailabilityServiceServer(server, availabilityServer{})
+	schedulerpb.RegisterRecommendationServiceServer(server, recommendationServer{})
+	reflection.Register(server)
+	return server
+}
+
+// eventServer implements EventService
+type eventServer struct {
+	schedulerpb.UnimplementedEventServiceServer
+}
+
+func (eventServer) CreateEvent(ctx context.Context, req *schedulerpb.CreateEventRequest) (*schedulerpb.Event, error) {
+	event, err := schedule.CreateEvent(eventFromProto(req.GetEvent()))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return eventToProto(event), nil
+}
+
+func (eventServer) GetEvent(ctx context.Context, req *schedulerpb.GetEventRequest) (*schedulerpb.Event, error) {
+	event, err := schedule.GetEvent(req.GetId())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return eventToProto(event), nil
+}
+
+func (eventServer) ListEvents(ctx context.Context, req *schedulerpb.ListEventsRequest) (*schedulerpb.ListEventsResponse, error) {
+	response := &schedulerpb.ListEventsResponse{}
+	for _, event := range schedule.ListEvents() {
+		response.Events = append(response.Events, eventToProto(event))
+	}
+	return response, nil
+}
+
+func (eventServer) UpdateEvent(ctx context.Context, req *schedulerpb.UpdateEventRequest) (*schedulerpb.Event, error) {
+	event, err := schedule.UpdateEvent(req.GetId(), eventFromProto(req.GetEvent()))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return eventToProto(event), nil
+}
+
+func (eventServer) PatchEvent(ctx context.Context, req *schedulerpb.PatchEventRequest) (*schedulerpb.Event, error) {
+	event, err := schedule.PatchEvent(req.GetId(), req.GetPatch().AsMap())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return eventToProto(event), nil
+}
+
+func (eventServer) DeleteEvent(ctx context.Context, req *schedulerpb.DeleteEventRequest) (*emptypb.Empty, error) {
+	if err := schedule.DeleteEvent(req.GetId()); err != nil {
+		return nil, grpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (eventServer) FinalizeEvent(ctx context.Context, req *schedulerpb.FinalizeEventRequest) (*schedulerpb.Event, error) {
+	event, err := schedule.FinalizeEvent(req.GetId(), slotFromProto(req.GetSlot()))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return eventToProto(event), nil
+}
+
+func (eventServer) ListEventSlots(ctx context.Context, req *schedulerpb.ListEventSlotsRequest) (*schedulerpb.ListEventSlotsResponse, error) {
+	slots, err := schedule.ListEventSlots(req.GetId())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &schedulerpb.ListEventSlotsResponse{Slots: slotsToProto(slots)}, nil
+}
+
+func (eventServer) AddEventSlot(ctx context.Context, req *schedulerpb.AddEventSlotRequest) (*schedulerpb.Slot, error) {
+	slot, err := schedule.AddEventSlot(req.GetId(), slotFromProto(req.GetSlot()))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return slotToProto(slot), nil
+}
+
+func (eventServer) DeleteEventSlot(ctx context.Context, req *schedulerpb.DeleteEventSlotRequest) (*emptypb.Empty, error) {
+	if err := schedule.DeleteEventSlot(req.GetId(), req.GetSlotId()); err != nil {
+		return nil, grpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// availabilityServer implements AvailabilityService
+type availabilityServer struct {
+	schedulerpb.UnimplementedAvailabilityServiceServer
+}
+
+func (availabilityServer) GetParticipant(ctx context.Context, req *schedulerpb.GetParticipantRequest) (*schedulerpb.GetParticipantResponse, error) {
+	responses, err := schedule.GetParticipant(req.GetParticipantId())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	response := &schedulerpb.GetParticipantResponse{}
+	for _, participant := range responses {
+		response.Events = append(response.Events, participantToProto(participant))
+	}
+	return response, nil
+}
+
+func (availabilityServer) ListParticipantSlots(ctx context.Context, req *schedulerpb.ListParticipantSlotsRequest) (*schedulerpb.ParticipantAvailability, error) {
+	participant, err := schedule.ListParticipantSlots(req.GetEventId(), req.GetParticipantId())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return participantToProto(participant), nil
+}
+
+func (availabilityServer) ReplaceParticipantSlots(ctx context.Context, req *schedulerpb.ReplaceParticipantSlotsRequest) (*schedulerpb.AvailabilityUpdate, error) {
+	update, err := schedule.ReplaceParticipantSlots(req.GetEventId(), req.GetParticipantId(), slotsFromProto(req.GetSlots()))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return availabilityUpdateToProto(update), nil
+}
+
+func (availabilityServer) AddParticipantSlot(ctx context.Context, req *schedulerpb.AddParticipantSlotRequest) (*schedulerpb.AvailabilityUpdate, error) {
+	update, err := schedule.AddParticipantSlot(req.GetEventId(), req.GetParticipantId(), slotFromProto(req.GetSlot()))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return availabilityUpdateToProto(update), nil
+}
+
+func (availabilityServer) RemoveParticipantSlots(ctx context.Context, req *schedulerpb.RemoveParticipantSlotsRequest) (*schedulerpb.AvailabilityUpdate, error) {
+	block := Slot{StartTime: timeFromProto(req.GetStartTime()), EndTime: timeFromProto(req.GetEndTime())}
+	update, err := schedule.RemoveParticipantSlots(req.GetEventId(), req.GetParticipantId(), block)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return availabilityUpdateToProto(update), nil
+}
+
+func (availabilityServer) DeleteParticipant(ctx context.Context, req *schedulerpb.DeleteParticipantRequest) (*emptypb.Empty, error) {
+	if err := schedule.DeleteParticipant(req.GetEventId(), req.GetParticipantId()); err != nil {
+		return nil, grpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (availabilityServer) ImportAvailability(ctx context.Context, req *schedulerpb.ImportAvailabilityRequest) (*schedulerpb.ImportAvailabilityResponse, error) {
+	if len(req.GetCsv()) > maxCSVUpload {
+		return nil, grpcError(newProblem(problemInvalidInput, "The CSV file is too large"))
+	}
+	availability, err := readAvailabilityCSV(bytes.NewReader(req.GetCsv()))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	imported, err := schedule.ImportAvailability(req.GetEventId(), availability)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &schedulerpb.ImportAvailabilityResponse{
+		Participants: int32(imported.Participants),
+		Slots:        int32(imported.Slots),
+		Normalized:   normalizationToProto(imported.Normalized),
+	}, nil
+}
+
+// recommendationServer implements RecommendationService
+type recommendationServer struct {
+	schedulerpb.UnimplementedRecommendationServiceServer
+}
+
+func (recommendationServer) FindCommonSlots(ctx context.Context, req *schedulerpb.FindCommonSlotsRequest) (*schedulerpb.FindCommonSlotsResponse, error) {
+	response, err := schedule.FindCommonSlots(req.GetEventId())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	result := &schedulerpb.FindCommonSlotsResponse{
+		RecommendedTimeSlots: slotRecommendationsToProto(response.RecommendedTimeSlots),
+	}
+	for _, recommendation := range response.SeriesRecommendations {
+		attendance := map[string]int32{}
+		for participantID, count := range recommendation.Attendance {
+			attendance[participantID] = int32(count)
+		}
+		result.SeriesRecommendations = append(result.SeriesRecommendations, &schedulerpb.SeriesRecommendation{
+			Slot:        slotToProto(recommendation.Slot),
+			Score:       int32(recommendation.Score),
+			Attendance:  attendance,
+			Occurrences: slotRecommendationsToProto(recommendation.Occurrences),
+		})
+	}
+	return result, nil
+}
+
+// Turn a scheduler error into a gRPC status. Field errors are sent as
+// BadRequest details.
+func grpcError(err error) error {
+	var problem *problemError
+	if !errors.As(err, &problem) {
+		return status.Error(codes.Internal, err.Error())
+	}
+	var code codes.Code
+	switch {
+	case problem.problem == problemAlreadyRecorded:
+		code = codes.AlreadyExists
+	case problem.problem.Status == 400 || problem.problem.Status == 422:
+		code = codes.InvalidArgument
+	case problem.problem.Status == 403 || problem.problem.Status == 409:
+		code = codes.FailedPrecondition
+	case problem.problem.Status == 404:
+		code = codes.NotFound
+	default:
+		code = codes.Internal
+	}
+	st := status.New(code, problem.Error())
+	if len(problem.fieldErrors) == 0 {
+		return st.Err()
+	}
+	badRequest := &errdetails.BadRequest{}
+	for _, fieldError := range problem.fieldErrors {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       fieldError.Field,
+			Description: fieldError.Message,
+		})
+	}
+	if withDetails, err := st.WithDetails(badRequest); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// Conversions between the scheduler's types and the protobuf messages
+
+func eventFromProto(event *schedulerpb.Event) Event {
+	result := Event{
+		Title:            event.GetTitle(),
+		Slots:            slotsFromProto(event.GetSlots()),
+		EstimatedTime:    event.GetEstimatedTime().AsDuration(),
+		Participants:     event.GetParticipants(),
+		Organizer:        event.GetOrganizer(),
+		Horizon:          optionalTimeFromProto(event.GetHorizon()),
+		ResponseDeadline: optionalTimeFromProto(event.GetResponseDeadline()),
+		AutoFinalize:     event.GetAutoFinalize(),
+	}
+	if series := event.GetSeries(); series != nil {
+		result.Series = &SeriesOptions{
+			IntervalWeeks: int(series.GetIntervalWeeks()),
+			Occurrences:   int(series.GetOccurrences()),
+			Zone:          series.GetZone(),
+		}
+	}
+	return result
+}
+
+func eventToProto(event Event) *schedulerpb.Event {
+	result := &schedulerpb.Event{
+		Id:               event.ID,
+		Title:            event.Title,
+		Slots:            slotsToProto(event.Slots),
+		EstimatedTime:    durationpb.New(event.EstimatedTime),
+		Participants:     event.Participants,
+		Organizer:        event.Organizer,
+		Horizon:          optionalTimeToProto(event.Horizon),
+		ResponseDeadline: optionalTimeToProto(event.ResponseDeadline),
+		AutoFinalize:     event.AutoFinalize,
+		Status:           event.Status,
+		AtRisk:           event.AtRisk,
+		Conflicts:        event.Conflicts,
+	}
+	if event.Series != nil {
+		result.Series = &schedulerpb.SeriesOptions{
+			IntervalWeeks: int32(event.Series.IntervalWeeks),
+			Occurrences:   int32(event.Series.Occurrences),
+			Zone:          event.Series.Zone,
+		}
+	}
+	if event.FinalizedSlot != nil {
+		result.FinalizedSlot = slotToProto(*event.FinalizedSlot)
+	}
+	return result
+}
+
+func slotFromProto(slot *schedulerpb.Slot) Slot {
+	result := Slot{
+		ID:         slot.GetId(),
+		StartTime:  timeFromProto(slot.GetStartTime()),
+		EndTime:    timeFromProto(slot.GetEndTime()),
+		RRule:      slot.GetRrule(),
+		Zone:       slot.GetZone(),
+		Preference: slot.GetPreference(),
+	}
+	for _, exdate := range slot.GetExdates() {
+		result.ExDates = append(result.ExDates, exdate.AsTime())
+	}
+	return result
+}
+
+func slotToProto(slot Slot) *schedulerpb.Slot {
+	result := &schedulerpb.Slot{
+		Id:         slot.ID,
+		StartTime:  timestamppb.New(slot.StartTime),
+		EndTime:    timestamppb.New(slot.EndTime),
+		Rrule:      slot.RRule,
+		Zone:       slot.Zone,
+		Preference: slot.Preference,
+		SlotIds:    slot.SlotIDs,
+	}
+	for _, exdate := range slot.ExDates {
+		result.Exdates = append(result.Exdates, timestamppb.New(exdate))
+	}
+	return result
+}
+
+func slotsFromProto(slots []*schedulerpb.Slot) []Slot {
+	var result []Slot
+	for _, slot := range slots {
+		result = append(result, slotFromProto(slot))
+	}
+	return result
+}
+
+func slotsToProto(slots []Slot) []*schedulerpb.Slot {
+	var result []*schedulerpb.Slot
+	for _, slot := range slots {
+		result = append(result, slotToProto(slot))
+	}
+	return result
+}
+
+func participantToProto(participant Participant) *schedulerpb.ParticipantAvailability {
+	return &schedulerpb.ParticipantAvailability{
+		ParticipantId: participant.ID,
+		EventId:       participant.EventID,
+		Availability:  slotsToProto(participant.Availability),
+	}
+}
+
+func availabilityUpdateToProto(update availabilityUpdate) *schedulerpb.AvailabilityUpdate {
+	return &schedulerpb.AvailabilityUpdate{
+		Availability: slotsToProto(update.Availability),
+		Normalized:   normalizationToProto(update.Normalized),
+		Created:      update.Created,
+	}
+}
+
+func normalizationToProto(normalized AvailabilityNormalization) *schedulerpb.AvailabilityNormalization {
+	return &schedulerpb.AvailabilityNormalization{
+		Merged:  int32(normalized.Merged),
+		Clipped: int32(normalized.Clipped),
+		Dropped: int32(normalized.Dropped),
+	}
+}
+
+func slotRecommendationsToProto(recommendations []SlotUnavailable) []*schedulerpb.SlotRecommendation {
+	var result []*schedulerpb.SlotRecommendation
+	for _, recommendation := range recommendations {
+		result = append(result, &schedulerpb.SlotRecommendation{
+			Slot:                    slotToProto(recommendation.Slot),
+			UnavailableParticipants: recommendation.UnavailableParticipants,
+		})
+	}
+	return result
+}
+
+// A missing timestamp is the zero time, so validation reports it as required
+func timeFromProto(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
+func optionalTimeFromProto(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	result := t.AsTime()
+	return &result
+}
+
+func optionalTimeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
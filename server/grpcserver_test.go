@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/deepakg86/go-event-scheduler/schedulerpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Start the gRPC server on an in-memory listener and connect to it
+func setupGRPC(t *testing.T) *grpc.ClientConn {
+	listener := bufconn.Listen(1 << 20)
+	server := newGRPCServer()
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestGRPCScheduling(t *testing.T) {
+	conn := setupGRPC(t)
+	ctx := context.Background()
+	eventClient := schedulerpb.NewEventServiceClient(conn)
+	availabilityClient := schedulerpb.NewAvailabilityServiceClient(conn)
+	recommendationClient := schedulerpb.NewRecommendationServiceClient(conn)
+	delete(participants, "g1")
+	delete(participants, "g2")
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	slot := func(from, to time.Duration) *schedulerpb.Slot {
+		return &schedulerpb.Slot{StartTime: timestamppb.New(start.Add(from)), EndTime: timestamppb.New(start.Add(to))}
+	}
+	event, err := eventClient.CreateEvent(ctx, &schedulerpb.CreateEventRequest{Event: &schedulerpb.Event{
+		Title:         "gRPC meeting",
+		Slots:         []*schedulerpb.Slot{slot(0, time.Hour), slot(24*time.Hour, 25*time.Hour)},
+		EstimatedTime: durationpb.New(time.Hour),
+		Participants:  []string{"g1", "g2"},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, EventStatusOpen, event.Status)
+	assert.Equal(t, "1", event.Slots[0].Id)
+	assert.Equal(t, "2", event.Slots[1].Id)
+
+	// Both APIs share the same events
+	stored, err := schedule.GetEvent(event.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "gRPC meeting", stored.Title)
+	listed, err := eventClient.ListEvents(ctx, &schedulerpb.ListEventsRequest{})
+	require.NoError(t, err)
+	var listedIDs []string
+	for _, listedEvent := range listed.Events {
+		listedIDs = append(listedIDs, listedEvent.Id)
+	}
+	assert.Contains(t, listedIDs, event.Id)
+
+	// A merge patch goes through the same code path as PATCH /v1/events/{id}
+	patch, err := structpb.NewStruct(map[string]interface{}{"title": "Patched over gRPC", "organizer": nil})
+	require.NoError(t, err)
+	patched, err := eventClient.PatchEvent(ctx, &schedulerpb.PatchEventRequest{Id: event.Id, Patch: patch})
+	require.NoError(t, err)
+	assert.Equal(t, "Patched over gRPC", patched.Title)
+	assert.Len(t, patched.Slots, 2)
+	patch, err = structpb.NewStruct(map[string]interface{}{"status": "closed"})
+	require.NoError(t, err)
+	_, err = eventClient.PatchEvent(ctx, &schedulerpb.PatchEventRequest{Id: event.Id, Patch: patch})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Only the second slot works for both participants
+	update, err := availabilityClient.ReplaceParticipantSlots(ctx, &schedulerpb.ReplaceParticipantSlotsRequest{
+		EventId:       event.Id,
+		ParticipantId: "g1",
+		Slots:         []*schedulerpb.Slot{slot(0, 30*time.Minute), slot(30*time.Minute, time.Hour), slot(24*time.Hour, 25*time.Hour)},
+	})
+	require.NoError(t, err)
+	assert.True(t, update.Created)
+	assert.Len(t, update.Availability, 2)
+	assert.Equal(t, int32(1), update.Normalized.Merged)
+	_, err = availabilityClient.AddParticipantSlot(ctx, &schedulerpb.AddParticipantSlotRequest{
+		EventId:       event.Id,
+		ParticipantId: "g2",
+		Slot:          slot(24*time.Hour, 25*time.Hour),
+	})
+	require.NoError(t, err)
+
+	recommendations, err := recommendationClient.FindCommonSlots(ctx, &schedulerpb.FindCommonSlotsRequest{EventId: event.Id})
+	require.NoError(t, err)
+	if assert.Len(t, recommendations.RecommendedTimeSlots, 1) {
+		assert.Equal(t, "2", recommendations.RecommendedTimeSlots[0].Slot.Id)
+		assert.Empty(t, recommendations.RecommendedTimeSlots[0].UnavailableParticipants)
+	}
+
+	finalized, err := eventClient.FinalizeEvent(ctx, &schedulerpb.FinalizeEventRequest{Id: event.Id, Slot: &schedulerpb.Slot{Id: "2"}})
+	require.NoError(t, err)
+	assert.True(t, finalized.FinalizedSlot.StartTime.AsTime().Equal(start.Add(24*time.Hour)))
+
+	_, err = availabilityClient.DeleteParticipant(ctx, &schedulerpb.DeleteParticipantRequest{EventId: event.Id, ParticipantId: "g2"})
+	require.NoError(t, err)
+	participant, err := availabilityClient.GetParticipant(ctx, &schedulerpb.GetParticipantRequest{ParticipantId: "g1"})
+	require.NoError(t, err)
+	if assert.Len(t, participant.Events, 1) {
+		assert.Equal(t, event.Id, participant.Events[0].EventId)
+	}
+
+	// A CSV import is all or nothing, like POST /v1/events/{id}/participants/import
+	delete(participants, "g4")
+	imported, err := availabilityClient.ImportAvailability(ctx, &schedulerpb.ImportAvailabilityRequest{
+		EventId: event.Id,
+		Csv:     []byte("participant_id,start,end\ng4,2025-01-14T14:00:00Z,2025-01-14T16:00:00Z\n"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), imported.Participants)
+	assert.Equal(t, int32(1), imported.Slots)
+	assert.Equal(t, int32(1), imported.Normalized.Clipped)
+	_, err = availabilityClient.ImportAvailability(ctx, &schedulerpb.ImportAvailabilityRequest{
+		EventId: event.Id,
+		Csv:     []byte("participant_id,start,end\ng5,2025-01-14T16:00:00Z,2025-01-14T14:00:00Z\n"),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, found := participants["g5"]
+	assert.False(t, found)
+
+	_, err = eventClient.DeleteEvent(ctx, &schedulerpb.DeleteEventRequest{Id: event.Id})
+	require.NoError(t, err)
+}
+
+func TestGRPCErrors(t *testing.T) {
+	conn := setupGRPC(t)
+	ctx := context.Background()
+	eventClient := schedulerpb.NewEventServiceClient(conn)
+
+	_, err := eventClient.GetEvent(ctx, &schedulerpb.GetEventRequest{Id: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Validation failures list every invalid field
+	_, err = eventClient.CreateEvent(ctx, &schedulerpb.CreateEventRequest{Event: &schedulerpb.Event{
+		Slots: []*schedulerpb.Slot{{}},
+	}})
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	assert.Equal(t, []string{"title", "slots[0].start_time", "slots[0].end_time", "estimatedTime"}, fields)
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["grpc-closed"] = Event{
+		ID:            "grpc-closed",
+		Title:         "Closed meeting",
+		Slots:         []Slot{{ID: "1", StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime: time.Hour,
+		Status:        EventStatusClosed,
+	}
+	_, err = schedulerpb.NewAvailabilityServiceClient(conn).AddParticipantSlot(ctx, &schedulerpb.AddParticipantSlotRequest{
+		EventId:       "grpc-closed",
+		ParticipantId: "g3",
+		Slot:          &schedulerpb.Slot{StartTime: timestamppb.New(start), EndTime: timestamppb.New(start.Add(time.Hour))},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Availability that was already recorded is a conflict with existing state
+	assert.Equal(t, codes.AlreadyExists, status.Code(grpcError(newProblem(problemAlreadyRecorded, ""))))
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// Default and limits for heatmap bucket sizes
+const (
+	defaultHeatmapInterval = 30 * time.Minute
+	minHeatmapInterval     = 5 * time.Minute
+	maxHeatmapBuckets      = 10000
+)
+
+// HeatmapResponse is the availability grid and HeatmapBucket one of its
+// cells. Both are generated from openapi.yaml.
+type (
+	HeatmapResponse = api.Heatmap
+	HeatmapBucket   = api.HeatmapBucket
+)
+
+// Event Heatmap Handler
+func getEventHeatmap(w http.ResponseWriter, r *http.Request, eventID string, params api.GetEventHeatmapParams) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	// Parse the bucket size, such as 15m or 1h
+	interval := defaultHeatmapInterval
+	if params.Interval != "" {
+		parsed, err := time.ParseDuration(params.Interval)
+		if err != nil || parsed < minHeatmapInterval {
+			writeProblem(w, problemInvalidInput, "", FieldError{Field: "interval", Message: "interval must be a duration of at least 5m"})
+			return
+		}
+		interval = parsed
+	}
+	event, exists := events[eventID]
+	// If the event does not exist, return a 404 error
+	if !exists {
+		writeProblem(w, problemEventNotFound, "")
+		return
+	}
+	buckets, ok := eventHeatmap(eventID, event, interval)
+	if !ok {
+		writeProblem(w, problemInvalidInput, "", FieldError{Field: "interval", Message: "interval is too small for this event"})
+		return
+	}
+	participantIDs := event.Participants
+	if participantIDs == nil {
+		participantIDs = []string{}
+	}
+	// Return the grid
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(HeatmapResponse{
+		Interval:     interval.String(),
+		Participants: participantIDs,
+		Buckets:      buckets,
+	})
+}
+
+// Split the event's slots into buckets of the given size and find who is
+// available for the whole of each bucket. A trailing partial bucket is kept.
+// Reports false if the event needs more than maxHeatmapBuckets buckets.
+func eventHeatmap(eventID string, event Event, interval time.Duration) ([]HeatmapBucket, bool) {
+	availability := eventAvailability(eventID, event)
+
+	cells, ok := eventCells(event, interval)
+	if !ok {
+		return nil, false
+	}
+	buckets := []HeatmapBucket{}
+	for _, cell := range cells {
+		bucket := HeatmapBucket{StartTime: cell.StartTime, EndTime: cell.EndTime, AvailableParticipants: []string{}}
+		for _, participantID := range event.Participants {
+			if coversSlot(availability[participantID].Slots, cell) {
+				bucket.AvailableParticipants = append(bucket.AvailableParticipants, participantID)
+			}
+		}
+		bucket.Count = len(bucket.AvailableParticipants)
+		buckets = append(buckets, bucket)
+	}
+	return buckets, true
+}
+
+// Split the event's slots into cells of the given size, keeping a trailing
+// partial cell. Reports false if the event needs more than maxHeatmapBuckets
+// cells.
+func eventCells(event Event, interval time.Duration) ([]Slot, bool) {
+	var cells []Slot
+	for _, slot := range mergeSlots(eventSlots(event)) {
+		for start := slot.StartTime; start.Before(slot.EndTime); start = start.Add(interval) {
+			if len(cells) >= maxHeatmapBuckets {
+				return nil, false
+			}
+			end := start.Add(interval)
+			if end.After(slot.EndTime) {
+				end = slot.EndTime
+			}
+			cells = append(cells, Slot{StartTime: start, EndTime: end})
+		}
+	}
+	return cells, true
+}
+
+// Helper function to check if one of the merged slots covers the whole of slot
+func coversSlot(merged []Slot, slot Slot) bool {
+	for _, s := range merged {
+		if !s.StartTime.After(slot.StartTime) && !s.EndTime.Before(slot.EndTime) {
+			return true
+		}
+	}
+	return false
+}
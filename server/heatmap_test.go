@@ -0,0 +1,51 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEventHeatmap(t *testing.T) {
+	router := setupRouter()
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["heat"] = Event{
+		ID:            "heat",
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: start, EndTime: start.Add(90 * time.Minute)}},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"h1", "h2"},
+	}
+	// h1 is free 2:00-3:00 in two adjacent pieces, h2 is free 2:15-3:30
+	participants["h1"] = []Participant{{ID: "h1", EventID: "heat", Availability: []Slot{
+		{StartTime: start, EndTime: start.Add(30 * time.Minute)},
+		{StartTime: start.Add(30 * time.Minute), EndTime: start.Add(time.Hour)},
+	}}}
+	participants["h2"] = []Participant{{ID: "h2", EventID: "heat", Availability: []Slot{
+		{StartTime: start.Add(15 * time.Minute), EndTime: start.Add(90 * time.Minute)},
+	}}}
+
+	req, err := http.NewRequest("GET", "/event/heat/heatmap?interval=30m", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	var response HeatmapResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	if assert.Len(t, response.Buckets, 3) {
+		assert.Equal(t, []string{"h1"}, response.Buckets[0].AvailableParticipants)
+		assert.Equal(t, []string{"h1", "h2"}, response.Buckets[1].AvailableParticipants)
+		assert.Equal(t, 2, response.Buckets[1].Count)
+		assert.Equal(t, []string{"h2"}, response.Buckets[2].AvailableParticipants)
+	}
+}
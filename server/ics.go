@@ -0,0 +1,372 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const icsTimeFormat = "20060102T150405Z"
+
+// icsProperty is a single content line such as DTSTART;TZID=UTC:20250112T140000
+type icsProperty struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// icsComponent is a BEGIN/END block such as VCALENDAR or VEVENT
+type icsComponent struct {
+	Name       string
+	Properties []icsProperty
+	Components []icsComponent
+}
+
+// Get the first property with the given name, or nil
+func (c icsComponent) property(name string) *icsProperty {
+	for i := range c.Properties {
+		if c.Properties[i].Name == name {
+			return &c.Properties[i]
+		}
+	}
+	return nil
+}
+
+// Get every property with the given name
+func (c icsComponent) properties(name string) []icsProperty {
+	var props []icsProperty
+	for _, p := range c.Properties {
+		if p.Name == name {
+			props = append(props, p)
+		}
+	}
+	return props
+}
+
+// Event Calendar Export Handler
+func getEventCalendar(w http.ResponseWriter, r *http.Request, eventID string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, exists := events[eventID]
+	// If the event does not exist, return a 404 error
+	if !exists {
+		writeProblem(w, problemEventNotFound, "")
+		return
+	}
+	writeEventCalendar(w, event)
+}
+
+// Helper function to check if the client asked for an iCalendar response
+func wantsCalendar(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/calendar")
+}
+
+// Write the event as a text/calendar response
+func writeEventCalendar(w http.ResponseWriter, event Event) {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"event-%s.ics\"", event.ID))
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, eventCalendar(event))
+}
+
+// calendarEntry is one VEVENT exported for an event
+type calendarEntry struct {
+	UID    string
+	Slot   Slot
+	Status string
+}
+
+// Get a confirmed entry for the finalized slot and a tentative entry for every candidate slot
+func eventCalendarEntries(event Event) []calendarEntry {
+	var entries []calendarEntry
+	if event.FinalizedSlot != nil {
+		entries = append(entries, calendarEntry{
+			UID:    fmt.Sprintf("event-%s-final@go-event-scheduler", event.ID),
+			Slot:   *event.FinalizedSlot,
+			Status: "CONFIRMED",
+		})
+	}
+	for _, slot := range event.Slots {
+		entries = append(entries, calendarEntry{
+			UID:    fmt.Sprintf("event-%s-slot-%s@go-event-scheduler", event.ID, slot.ID),
+			Slot:   slot,
+			Status: "TENTATIVE",
+		})
+	}
+	return entries
+}
+
+// Build a VCALENDAR holding the given entries of the event
+func eventCalendar(event Event, entries ...calendarEntry) string {
+	if len(entries) == 0 {
+		entries = eventCalendarEntries(event)
+	}
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//go-event-scheduler//EN")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+	for _, entry := range entries {
+		writeEventVEvent(&b, event, entry)
+	}
+	writeICSLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+func writeEventVEvent(b *strings.Builder, event Event, entry calendarEntry) {
+	writeICSLine(b, "BEGIN:VEVENT")
+	writeICSLine(b, "UID:"+entry.UID)
+	writeICSLine(b, "DTSTAMP:"+clock.Now().UTC().Format(icsTimeFormat))
+	writeICSLine(b, "DTSTART"+icsSlotTime(entry.Slot, entry.Slot.StartTime))
+	writeICSLine(b, "DTEND"+icsSlotTime(entry.Slot, entry.Slot.EndTime))
+	if entry.Slot.RRule != "" {
+		writeICSLine(b, "RRULE:"+entry.Slot.RRule)
+		for _, exdate := range entry.Slot.ExDates {
+			writeICSLine(b, "EXDATE"+icsSlotTime(entry.Slot, exdate))
+		}
+	}
+	writeICSLine(b, "SUMMARY:"+escapeICSText(event.Title))
+	writeICSLine(b, "STATUS:"+entry.Status)
+	if event.Organizer != "" {
+		writeICSLine(b, "ORGANIZER;CN="+quoteICSParam(event.Organizer)+":"+participantAddress(event.Organizer))
+	}
+	for _, participantID := range event.Participants {
+		writeICSLine(b, "ATTENDEE;CN="+quoteICSParam(participantID)+":"+participantAddress(participantID))
+	}
+	writeICSLine(b, "END:VEVENT")
+}
+
+// Format a time of a slot as the parameters and value of a DATE-TIME property.
+// Recurring slots with a zone use local times with a TZID so calendars expand
+// them in that zone too.
+func icsSlotTime(slot Slot, t time.Time) string {
+	if slot.RRule != "" && slot.Zone != "" {
+		if loc, err := time.LoadLocation(slot.Zone); err == nil {
+			return ";TZID=" + slot.Zone + ":" + t.In(loc).Format("20060102T150405")
+		}
+	}
+	return ":" + t.UTC().Format(icsTimeFormat)
+}
+
+// Event Free/Busy Handler
+func getEventFreeBusy(w http.ResponseWriter, r *http.Request, eventID string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, exists := events[eventID]
+	// If the event does not exist, return a 404 error
+	if !exists {
+		writeProblem(w, problemEventNotFound, "")
+		return
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, freeBusyCalendar(eventID, event))
+}
+
+// Build a VCALENDAR with a VFREEBUSY covering the event's slots, where a period
+// is busy if any participant is unavailable for it
+func freeBusyCalendar(eventID string, event Event) string {
+	var busy []Slot
+	slots := eventSlots(event)
+	for _, availability := range eventAvailability(eventID, event) {
+		busy = append(busy, subtractSlots(slots, availability.Slots)...)
+	}
+	busy = mergeSlots(busy)
+
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//go-event-scheduler//EN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+	writeICSLine(&b, "BEGIN:VFREEBUSY")
+	writeICSLine(&b, fmt.Sprintf("UID:event-%s-freebusy@go-event-scheduler", eventID))
+	writeICSLine(&b, "DTSTAMP:"+clock.Now().UTC().Format(icsTimeFormat))
+	if slots := mergeSlots(eventSlots(event)); len(slots) > 0 {
+		writeICSLine(&b, "DTSTART:"+slots[0].StartTime.UTC().Format(icsTimeFormat))
+		writeICSLine(&b, "DTEND:"+slots[len(slots)-1].EndTime.UTC().Format(icsTimeFormat))
+	}
+	writeICSLine(&b, "SUMMARY:"+escapeICSText(event.Title))
+	for _, slot := range busy {
+		writeICSLine(&b, "FREEBUSY;FBTYPE=BUSY:"+slot.StartTime.UTC().Format(icsTimeFormat)+"/"+slot.EndTime.UTC().Format(icsTimeFormat))
+	}
+	writeICSLine(&b, "END:VFREEBUSY")
+	writeICSLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// Participants are plain IDs, so only those that look like email addresses get a mailto URI
+func participantAddress(participantID string) string {
+	if strings.Contains(participantID, "@") {
+		return "mailto:" + participantID
+	}
+	return "urn:x-participant:" + participantID
+}
+
+// Write a content line, folding it at 75 octets as RFC 5545 requires. The
+// space starting each continuation line counts, so they carry 74 octets.
+func writeICSLine(b *strings.Builder, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		// Do not split a multi-byte UTF-8 character
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = 74
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func escapeICSText(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return r.Replace(s)
+}
+
+func unescapeICSText(s string) string {
+	r := strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+	return r.Replace(s)
+}
+
+func quoteICSParam(s string) string {
+	if strings.ContainsAny(s, ":;,") {
+		return `"` + strings.ReplaceAll(s, `"`, "") + `"`
+	}
+	return s
+}
+
+// Parse an iCalendar stream into its top-level component
+func parseICS(r io.Reader) (icsComponent, error) {
+	lines, err := unfoldICSLines(r)
+	if err != nil {
+		return icsComponent{}, err
+	}
+	var stack []icsComponent
+	var root *icsComponent
+	for _, line := range lines {
+		prop, err := parseICSProperty(line)
+		if err != nil {
+			return icsComponent{}, err
+		}
+		switch prop.Name {
+		case "BEGIN":
+			stack = append(stack, icsComponent{Name: strings.ToUpper(prop.Value)})
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(prop.Value) {
+				return icsComponent{}, fmt.Errorf("unexpected END:%s", prop.Value)
+			}
+			done := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				if root != nil {
+					return icsComponent{}, fmt.Errorf("multiple top-level components")
+				}
+				root = &done
+			} else {
+				parent := &stack[len(stack)-1]
+				parent.Components = append(parent.Components, done)
+			}
+		default:
+			if len(stack) == 0 {
+				return icsComponent{}, fmt.Errorf("property %s outside of a component", prop.Name)
+			}
+			top := &stack[len(stack)-1]
+			top.Properties = append(top.Properties, prop)
+		}
+	}
+	if len(stack) != 0 || root == nil {
+		return icsComponent{}, fmt.Errorf("unterminated calendar")
+	}
+	return *root, nil
+}
+
+// Read content lines, joining folded continuation lines
+func unfoldICSLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// Split a content line into its name, parameters and value
+func parseICSProperty(line string) (icsProperty, error) {
+	// The value starts at the first colon that is not inside a quoted parameter
+	inQuotes := false
+	colon := -1
+	for i, c := range line {
+		if c == '"' {
+			inQuotes = !inQuotes
+		} else if c == ':' && !inQuotes {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return icsProperty{}, fmt.Errorf("invalid content line: %q", line)
+	}
+	prop := icsProperty{Params: map[string]string{}, Value: line[colon+1:]}
+	parts := splitICSParams(line[:colon])
+	prop.Name = strings.ToUpper(parts[0])
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		prop.Params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return prop, nil
+}
+
+func splitICSParams(s string) []string {
+	var parts []string
+	inQuotes := false
+	start := 0
+	for i, c := range s {
+		if c == '"' {
+			inQuotes = !inQuotes
+		} else if c == ';' && !inQuotes {
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// Parse a DATE or DATE-TIME property value. Floating times without a TZID are
+// read in loc. All-day values are reported so callers can treat them as
+// covering the whole day.
+func parseICSTime(prop icsProperty, loc *time.Location) (t time.Time, allDay bool, err error) {
+	if tzid, ok := prop.Params["TZID"]; ok {
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, false, fmt.Errorf("unknown TZID %q", tzid)
+		}
+	}
+	value := prop.Value
+	if prop.Params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		t, err = time.ParseInLocation("20060102", value, loc)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err = time.Parse(icsTimeFormat, value)
+		return t, false, err
+	}
+	t, err = time.ParseInLocation("20060102T150405", value, loc)
+	return t, false, err
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventCalendarRoundTrip(t *testing.T) {
+	router := setupRouter()
+
+	start := time.Date(2025, 1, 12, 19, 0, 0, 0, time.UTC)
+	slots := []Slot{
+		{StartTime: start, EndTime: start.Add(2 * time.Hour)},
+		{StartTime: start.Add(48 * time.Hour), EndTime: start.Add(51 * time.Hour)},
+	}
+	finalized := slots[1]
+	events["ics"] = Event{
+		ID:            "ics",
+		Title:         "Brainstorming, part 2; with a title long enough to need folding across lines",
+		Slots:         slots,
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"alice@example.com", "bob"},
+		FinalizedSlot: &finalized,
+	}
+
+	req, err := http.NewRequest("GET", "/events/ics.ics", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	assert.True(t, strings.HasPrefix(rr.Header().Get("Content-Type"), "text/calendar"))
+
+	calendar, err := parseICS(rr.Body)
+	if err != nil {
+		t.Fatalf("could not parse calendar: %v", err)
+	}
+	assert.Equal(t, "VCALENDAR", calendar.Name)
+	if !assert.Len(t, calendar.Components, 3, "Expected a finalized and two tentative VEVENTs") {
+		return
+	}
+
+	confirmed := calendar.Components[0]
+	assert.Equal(t, "CONFIRMED", confirmed.property("STATUS").Value)
+	assert.Equal(t, "event-ics-final@go-event-scheduler", confirmed.property("UID").Value)
+	assert.Equal(t, events["ics"].Title, unescapeICSText(confirmed.property("SUMMARY").Value))
+
+	for i, vevent := range calendar.Components[1:] {
+		assert.Equal(t, "TENTATIVE", vevent.property("STATUS").Value)
+		dtstart, _, err := parseICSTime(*vevent.property("DTSTART"), time.UTC)
+		assert.NoError(t, err)
+		dtend, _, err := parseICSTime(*vevent.property("DTEND"), time.UTC)
+		assert.NoError(t, err)
+		assert.True(t, dtstart.Equal(slots[i].StartTime), "DTSTART should match the slot")
+		assert.True(t, dtend.Equal(slots[i].EndTime), "DTEND should match the slot")
+
+		attendees := vevent.properties("ATTENDEE")
+		if assert.Len(t, attendees, 2) {
+			assert.Equal(t, "mailto:alice@example.com", attendees[0].Value)
+			assert.Equal(t, "bob", attendees[1].Params["CN"])
+		}
+	}
+}
+
+func TestEventCalendarUIDsFollowSlotIDs(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["ics-uid"] = Event{
+		ID:    "ics-uid",
+		Title: "Renumbered",
+		Slots: []Slot{
+			{ID: "1", StartTime: start, EndTime: start.Add(time.Hour)},
+			{ID: "2", StartTime: start.Add(24 * time.Hour), EndTime: start.Add(25 * time.Hour)},
+		},
+		lastSlotID: 2,
+	}
+	require.NoError(t, schedule.DeleteEventSlot("ics-uid", "1"))
+
+	// The remaining slot keeps its UID once the slot before it is gone
+	entries := eventCalendarEntries(events["ics-uid"])
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "event-ics-uid-slot-2@go-event-scheduler", entries[0].UID)
+	}
+}
+
+func TestWriteICSLineFoldsLongText(t *testing.T) {
+	summary := strings.Repeat("Réunion d'équipe — 計画会議 ", 8)
+	var b strings.Builder
+	writeICSLine(&b, "SUMMARY:"+escapeICSText(summary))
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+	assert.Greater(t, len(lines), 2, "Expected the line to be folded")
+	for i, line := range lines {
+		assert.LessOrEqual(t, len(line), 75, "Line %d is longer than 75 octets", i)
+		assert.True(t, utf8.ValidString(line), "Line %d splits a character", i)
+		if i > 0 {
+			assert.True(t, strings.HasPrefix(line, " "), "Continuation line %d should start with a space", i)
+		}
+	}
+
+	calendar, err := parseICS(strings.NewReader("BEGIN:VCALENDAR\r\n" + b.String() + "END:VCALENDAR\r\n"))
+	if err != nil {
+		t.Fatalf("could not parse calendar: %v", err)
+	}
+	assert.Equal(t, summary, unescapeICSText(calendar.property("SUMMARY").Value))
+}
+
+func TestGetEventAcceptCalendar(t *testing.T) {
+	router := setupRouter()
+
+	events["1"] = Event{
+		ID:            "1",
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: time.Now(), EndTime: time.Now().Add(1 * time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+	}
+
+	req, err := http.NewRequest("GET", "/events/1", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	req.Header.Set("Accept", "text/calendar")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	assert.Contains(t, rr.Body.String(), "BEGIN:VEVENT")
+}
+
+func TestGetEventFreeBusy(t *testing.T) {
+	router := setupRouter()
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["fb"] = Event{
+		ID:            "fb",
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: start, EndTime: start.Add(3 * time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"fb1", "fb2"},
+	}
+	// fb1 is free 2-4PM and fb2 is free 3-5PM, so 2-3PM and 4-5PM are busy
+	participants["fb1"] = []Participant{{ID: "fb1", EventID: "fb", Availability: []Slot{{StartTime: start, EndTime: start.Add(2 * time.Hour)}}}}
+	participants["fb2"] = []Participant{{ID: "fb2", EventID: "fb", Availability: []Slot{{StartTime: start.Add(time.Hour), EndTime: start.Add(3 * time.Hour)}}}}
+
+	req, err := http.NewRequest("GET", "/event/fb/freebusy", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	calendar, err := parseICS(rr.Body)
+	if err != nil {
+		t.Fatalf("could not parse calendar: %v", err)
+	}
+	if !assert.Len(t, calendar.Components, 1) {
+		return
+	}
+	busy, err := freeBusySlots(calendar.Components[0], time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, []Slot{
+		{StartTime: start, EndTime: start.Add(time.Hour)},
+		{StartTime: start.Add(2 * time.Hour), EndTime: start.Add(3 * time.Hour)},
+	}, busy)
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// Maximum size of an uploaded calendar
+const maxCalendarUpload = 10 << 20
+
+// Helper function to check if the request carries an iCalendar upload
+func isCalendarUpload(r *http.Request) bool {
+	contentType := r.Header.Get("Content-Type")
+	return strings.HasPrefix(contentType, "text/calendar") || strings.HasPrefix(contentType, "multipart/form-data")
+}
+
+// Read an uploaded calendar along with the participant and event it is for.
+// A text/calendar body takes the IDs from the query parameters, and a multipart
+// form takes them from form fields next to a "calendar" file.
+// An optional "zone" names the time zone for floating times and all-day events.
+// Uploads larger than maxCalendarUpload fail with an *http.MaxBytesError.
+func readCalendarUpload(w http.ResponseWriter, r *http.Request, params api.CreateParticipantAvailabilityParams) (participantID string, eventID string, calendar icsComponent, loc *time.Location, err error) {
+	var body io.Reader
+	var zone string
+	r.Body = http.MaxBytesReader(w, r.Body, maxCalendarUpload)
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if err = r.ParseMultipartForm(maxCalendarUpload); err != nil {
+			return "", "", icsComponent{}, nil, err
+		}
+		file, _, err := r.FormFile("calendar")
+		if err != nil {
+			return "", "", icsComponent{}, nil, err
+		}
+		defer file.Close()
+		body = file
+		participantID = r.FormValue("participant_id")
+		eventID = r.FormValue("event_id")
+		zone = r.FormValue("zone")
+	} else {
+		body = r.Body
+		participantID = params.ParticipantId
+		eventID = params.EventId
+		zone = params.Zone
+	}
+	if participantID == "" || eventID == "" {
+		return "", "", icsComponent{}, nil, fmt.Errorf("participant_id and event_id are required")
+	}
+	loc = time.UTC
+	if zone != "" {
+		if loc, err = time.LoadLocation(zone); err != nil {
+			return "", "", icsComponent{}, nil, fmt.Errorf("unknown zone %q", zone)
+		}
+	}
+	calendar, err = parseICS(body)
+	if err != nil {
+		return "", "", icsComponent{}, nil, err
+	}
+	if calendar.Name != "VCALENDAR" {
+		return "", "", icsComponent{}, nil, fmt.Errorf("expected VCALENDAR, got %s", calendar.Name)
+	}
+	return participantID, eventID, calendar, loc, nil
+}
+
+// Compute the free time within the event's slots from a calendar's busy data.
+// Floating times and all-day events are read in loc.
+func freeSlotsFromCalendar(calendar icsComponent, eventSlots []Slot, loc *time.Location) ([]Slot, error) {
+	if len(eventSlots) == 0 {
+		return nil, nil
+	}
+	from, horizon := eventSlots[0].StartTime, eventSlots[0].EndTime
+	for _, slot := range eventSlots {
+		if slot.StartTime.Before(from) {
+			from = slot.StartTime
+		}
+		if slot.EndTime.After(horizon) {
+			horizon = slot.EndTime
+		}
+	}
+	busy, err := calendarBusySlots(calendar, from, horizon, loc)
+	if err != nil {
+		return nil, err
+	}
+	return subtractSlots(eventSlots, busy), nil
+}
+
+// Collect the busy intervals from every VEVENT and VFREEBUSY in the calendar
+// between from and horizon, expanding recurring events
+func calendarBusySlots(calendar icsComponent, from time.Time, horizon time.Time, loc *time.Location) ([]Slot, error) {
+	// Instances moved by a RECURRENCE-ID override are dropped from their series
+	overridden := map[string][]time.Time{}
+	for _, component := range calendar.Components {
+		if component.Name != "VEVENT" {
+			continue
+		}
+		if rid := component.property("RECURRENCE-ID"); rid != nil {
+			t, _, err := parseICSTime(*rid, loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RECURRENCE-ID: %v", err)
+			}
+			uid := ""
+			if p := component.property("UID"); p != nil {
+				uid = p.Value
+			}
+			overridden[uid] = append(overridden[uid], t)
+		}
+	}
+
+	var busy []Slot
+	for _, component := range calendar.Components {
+		switch component.Name {
+		case "VEVENT":
+			slots, err := veventBusySlots(component, from, horizon, loc, overridden)
+			if err != nil {
+				return nil, err
+			}
+			busy = append(busy, slots...)
+		case "VFREEBUSY":
+			slots, err := freeBusySlots(component, loc)
+			if err != nil {
+				return nil, err
+			}
+			busy = append(busy, slots...)
+		}
+	}
+	return busy, nil
+}
+
+// Get the busy intervals for a single VEVENT between from and horizon,
+// including its recurrences. A recurrence the scheduler cannot expand is
+// treated as busy for the whole window rather than failing the upload.
+func veventBusySlots(vevent icsComponent, from time.Time, horizon time.Time, loc *time.Location, overridden map[string][]time.Time) ([]Slot, error) {
+	// Transparent and cancelled events do not block time
+	if p := vevent.property("TRANSP"); p != nil && strings.EqualFold(p.Value, "TRANSPARENT") {
+		return nil, nil
+	}
+	if p := vevent.property("STATUS"); p != nil && strings.EqualFold(p.Value, "CANCELLED") {
+		return nil, nil
+	}
+	dtstartProp := vevent.property("DTSTART")
+	if dtstartProp == nil {
+		return nil, fmt.Errorf("VEVENT without DTSTART")
+	}
+	start, allDay, err := parseICSTime(*dtstartProp, loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DTSTART: %v", err)
+	}
+
+	// Work out how long each occurrence lasts
+	var length time.Duration
+	if p := vevent.property("DTEND"); p != nil {
+		end, _, err := parseICSTime(*p, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DTEND: %v", err)
+		}
+		length = end.Sub(start)
+	} else if p := vevent.property("DURATION"); p != nil {
+		if length, err = parseICSDuration(p.Value); err != nil {
+			return nil, err
+		}
+	} else if allDay {
+		length = 24 * time.Hour
+	}
+	if length <= 0 {
+		return nil, nil
+	}
+
+	starts := []time.Time{start}
+	if p := vevent.property("RRULE"); p != nil && vevent.property("RECURRENCE-ID") == nil {
+		rule, err := parseRRule(p.Value)
+		if err != nil {
+			return recurrenceBusySlots(start, from, horizon), nil
+		}
+		// Occurrences starting before the window can still run into it
+		expanded, complete := rule.expand(start, from.Add(-length), horizon)
+		if !complete {
+			return recurrenceBusySlots(start, from, horizon), nil
+		}
+		starts = expanded
+	}
+
+	// Drop excluded and overridden instances
+	var excluded []time.Time
+	for _, p := range vevent.properties("EXDATE") {
+		for _, value := range strings.Split(p.Value, ",") {
+			t, _, err := parseICSTime(icsProperty{Params: p.Params, Value: value}, loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid EXDATE: %v", err)
+			}
+			excluded = append(excluded, t)
+		}
+	}
+	if vevent.property("RECURRENCE-ID") == nil {
+		if uid := vevent.property("UID"); uid != nil {
+			excluded = append(excluded, overridden[uid.Value]...)
+		}
+	}
+
+	var busy []Slot
+	for _, s := range starts {
+		if containsTime(excluded, s) {
+			continue
+		}
+		busy = append(busy, Slot{StartTime: s, EndTime: s.Add(length)})
+	}
+	return busy, nil
+}
+
+// Get the busy time of a recurring VEVENT whose occurrences cannot be worked
+// out: everything in the window from its first occurrence on
+func recurrenceBusySlots(start time.Time, from time.Time, horizon time.Time) []Slot {
+	if start.Before(from) {
+		start = from
+	}
+	if !start.Before(horizon) {
+		return nil
+	}
+	return []Slot{{StartTime: start, EndTime: horizon}}
+}
+
+// Get the busy periods listed in a VFREEBUSY component
+func freeBusySlots(vfreebusy icsComponent, loc *time.Location) ([]Slot, error) {
+	var busy []Slot
+	for _, p := range vfreebusy.properties("FREEBUSY") {
+		if fbtype, ok := p.Params["FBTYPE"]; ok && strings.EqualFold(fbtype, "FREE") {
+			continue
+		}
+		for _, period := range strings.Split(p.Value, ",") {
+			slot, err := parseICSPeriod(period, loc)
+			if err != nil {
+				return nil, err
+			}
+			busy = append(busy, slot)
+		}
+	}
+	return busy, nil
+}
+
+// Parse a period of time such as 20250112T140000Z/20250112T150000Z or 20250112T140000Z/PT1H
+func parseICSPeriod(value string, loc *time.Location) (Slot, error) {
+	startValue, endValue, ok := strings.Cut(value, "/")
+	if !ok {
+		return Slot{}, fmt.Errorf("invalid period %q", value)
+	}
+	start, _, err := parseICSTime(icsProperty{Value: startValue}, loc)
+	if err != nil {
+		return Slot{}, fmt.Errorf("invalid period %q", value)
+	}
+	if strings.HasPrefix(endValue, "P") || strings.HasPrefix(endValue, "+P") {
+		length, err := parseICSDuration(endValue)
+		if err != nil {
+			return Slot{}, err
+		}
+		return Slot{StartTime: start, EndTime: start.Add(length)}, nil
+	}
+	end, _, err := parseICSTime(icsProperty{Value: endValue}, loc)
+	if err != nil {
+		return Slot{}, fmt.Errorf("invalid period %q", value)
+	}
+	return Slot{StartTime: start, EndTime: end}, nil
+}
+
+func containsTime(times []time.Time, t time.Time) bool {
+	for _, v := range times {
+		if v.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
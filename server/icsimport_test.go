@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateParticipantAvailabilityFromCalendar(t *testing.T) {
+	router := setupRouter()
+
+	// Three candidate afternoons: Mon 13th, Tue 14th and Wed 15th January 2025
+	day := func(d int, h int) time.Time { return time.Date(2025, 1, d, h, 0, 0, 0, time.UTC) }
+	events["import"] = Event{
+		ID:    "import",
+		Title: "Test Event",
+		Slots: []Slot{
+			{StartTime: day(13, 14), EndTime: day(13, 17)},
+			{StartTime: day(14, 14), EndTime: day(14, 17)},
+			{StartTime: day(15, 14), EndTime: day(15, 17)},
+		},
+		EstimatedTime: 1 * time.Hour,
+	}
+	delete(participants, "cal")
+
+	// A daily 3-4PM standup (skipped on the 14th) and an all-day event on the 15th
+	calendar := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"UID:standup",
+		"DTSTART:20250110T150000Z",
+		"DTEND:20250110T160000Z",
+		"RRULE:FREQ=DAILY",
+		"EXDATE:20250114T150000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:offsite",
+		"DTSTART;VALUE=DATE:20250115",
+		"DTEND;VALUE=DATE:20250116",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	form.WriteField("participant_id", "cal")
+	form.WriteField("event_id", "import")
+	file, _ := form.CreateFormFile("calendar", "busy.ics")
+	file.Write([]byte(calendar))
+	form.Close()
+
+	req, err := http.NewRequest("POST", "/participant", &body)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	if !assert.Len(t, participants["cal"], 1) {
+		return
+	}
+	assert.Equal(t, []Slot{
+		{StartTime: day(13, 14), EndTime: day(13, 15)},
+		{StartTime: day(13, 16), EndTime: day(13, 17)},
+		{StartTime: day(14, 14), EndTime: day(14, 17)},
+	}, participants["cal"][0].Availability)
+}
+
+func TestFreeSlotsFromFreeBusy(t *testing.T) {
+	calendar, err := parseICS(strings.NewReader(strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VFREEBUSY",
+		"FREEBUSY;FBTYPE=BUSY:20250113T140000Z/PT30M,20250113T160000Z/20250113T170000Z",
+		"FREEBUSY;FBTYPE=FREE:20250113T150000Z/PT1H",
+		"END:VFREEBUSY",
+		"END:VCALENDAR",
+	}, "\n")))
+	if err != nil {
+		t.Fatalf("could not parse calendar: %v", err)
+	}
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	free, err := freeSlotsFromCalendar(calendar, []Slot{{StartTime: start, EndTime: start.Add(3 * time.Hour)}}, time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, []Slot{{StartTime: start.Add(30 * time.Minute), EndTime: start.Add(2 * time.Hour)}}, free)
+}
+
+func TestFreeSlotsFromLongRunningRecurrences(t *testing.T) {
+	calendar, err := parseICS(strings.NewReader(strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		// A daily standup that started years before the event
+		"BEGIN:VEVENT",
+		"DTSTART:20100104T090000Z",
+		"DTEND:20100104T091500Z",
+		"RRULE:FREQ=DAILY",
+		"END:VEVENT",
+		// A review on the first Monday of every month
+		"BEGIN:VEVENT",
+		"DTSTART:20250106T100000Z",
+		"DTEND:20250106T110000Z",
+		"RRULE:FREQ=MONTHLY;BYDAY=1MO",
+		"END:VEVENT",
+		// A rule the scheduler cannot expand blocks the rest of the window
+		"BEGIN:VEVENT",
+		"DTSTART:20260106T110000Z",
+		"DTEND:20260106T113000Z",
+		"RRULE:FREQ=HOURLY",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\n")))
+	if err != nil {
+		t.Fatalf("could not parse calendar: %v", err)
+	}
+
+	at := func(d int, h int, m int) time.Time { return time.Date(2026, 1, d, h, m, 0, 0, time.UTC) }
+	free, err := freeSlotsFromCalendar(calendar, []Slot{
+		{StartTime: at(5, 8, 0), EndTime: at(5, 12, 0)},
+		{StartTime: at(6, 8, 0), EndTime: at(6, 12, 0)},
+	}, time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, []Slot{
+		{StartTime: at(5, 8, 0), EndTime: at(5, 9, 0)},
+		{StartTime: at(5, 9, 15), EndTime: at(5, 10, 0)},
+		{StartTime: at(5, 11, 0), EndTime: at(5, 12, 0)},
+		{StartTime: at(6, 8, 0), EndTime: at(6, 9, 0)},
+		{StartTime: at(6, 9, 15), EndTime: at(6, 11, 0)},
+	}, free)
+}
+
+func TestCalendarUploadTooLarge(t *testing.T) {
+	router := setupRouter()
+	large := "BEGIN:VCALENDAR\r\n" + strings.Repeat("X-FILLER:"+strings.Repeat("x", 1000)+"\r\n", maxCalendarUpload/1000) + "END:VCALENDAR\r\n"
+
+	var form bytes.Buffer
+	writer := multipart.NewWriter(&form)
+	writer.WriteField("participant_id", "large")
+	writer.WriteField("event_id", "large")
+	file, _ := writer.CreateFormFile("calendar", "busy.ics")
+	file.Write([]byte(large))
+	writer.Close()
+
+	for contentType, body := range map[string]string{
+		"text/calendar":              large,
+		writer.FormDataContentType(): form.String(),
+	} {
+		req, err := http.NewRequest("POST", "/participant?participant_id=large&event_id=large", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("could not create request: %v", err)
+		}
+		req.Header.Set("Content-Type", contentType)
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code, contentType)
+		assert.Contains(t, rr.Body.String(), "The calendar is too large", contentType)
+	}
+}
+
+// A calendar that is still uploading must not hold up other requests
+func TestCalendarUploadDoesNotBlockOtherRequests(t *testing.T) {
+	router := setupRouter()
+	events["slow-upload"] = Event{
+		ID:            "slow-upload",
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 13, 17, 0, 0, 0, time.UTC)}},
+		EstimatedTime: 1 * time.Hour,
+	}
+	delete(participants, "slow")
+
+	body, upload := io.Pipe()
+	req := httptest.NewRequest("POST", "/participant?participant_id=slow&event_id=slow-upload", body)
+	req.Header.Set("Content-Type", "text/calendar")
+	rr := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		router.ServeHTTP(rr, req)
+	}()
+	io.WriteString(upload, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n")
+
+	read := make(chan error)
+	go func() {
+		_, err := schedule.GetEvent("slow-upload")
+		read <- err
+	}()
+	select {
+	case err := <-read:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the event to be readable while the calendar is uploading")
+	}
+
+	io.WriteString(upload, "END:VCALENDAR\r\n")
+	upload.Close()
+	<-done
+	assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+}
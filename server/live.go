@@ -0,0 +1,448 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+	"github.com/gorilla/websocket"
+)
+
+// Live sessions share one availability grid per event
+const (
+	liveCellInterval = defaultHeatmapInterval
+	// How many changes are kept for clients resuming after a reconnect
+	liveLogSize = 256
+	// How many messages a connection can fall behind before it is dropped
+	liveBuffer = 64
+	// How often idle connections are pinged, and how long a pong may take
+	livePingInterval = 30 * time.Second
+	livePongTimeout  = 60 * time.Second
+	liveWriteTimeout = 10 * time.Second
+	liveMaxMessage   = 64 << 10
+)
+
+// liveSession is the shared state of an event's live connections
+type liveSession struct {
+	version int64
+	// The latest changes, oldest first
+	changes []liveChange
+	conns   map[*liveConn]struct{}
+}
+
+// liveChange is one versioned change to the grid
+type liveChange struct {
+	Version int64
+	// The participant whose availability changed, or empty when the whole
+	// grid changed because the event was edited
+	ParticipantID string
+	// The cells painted, or nil when the change came from outside the session
+	// and may have touched any of the participant's cells
+	Cells []Slot
+	// The connection that painted the cells
+	Conn *liveConn
+}
+
+// liveConn is a client connected to an event's live session
+type liveConn struct {
+	send chan interface{}
+}
+
+// Live sessions by event ID, guarded by mu
+var liveSessions = map[string]*liveSession{}
+
+// A client's request to mark the cells between each start and end time as
+// available or not for a participant
+type livePaint struct {
+	Type          string `json:"type"`
+	OpID          string `json:"op_id"`
+	ParticipantID string `json:"participant_id"`
+	// The grid version the client painted on
+	BaseVersion int64  `json:"base_version"`
+	Available   bool   `json:"available"`
+	Cells       []Slot `json:"cells"`
+}
+
+// The whole grid, sent when a client joins or the event changes
+type liveSnapshot struct {
+	Type    string `json:"type"`
+	Version int64  `json:"version"`
+	HeatmapResponse
+}
+
+// The cells a participant is available for, sent after their availability
+// changes and as the reply to a paint that conflicts with another change
+type liveParticipant struct {
+	Type          string `json:"type"`
+	Version       int64  `json:"version"`
+	OpID          string `json:"op_id,omitempty"`
+	ParticipantID string `json:"participant_id"`
+	Cells         []Slot `json:"cells"`
+}
+
+// The reply to a paint that was applied
+type liveAck struct {
+	Type    string `json:"type"`
+	Version int64  `json:"version"`
+	OpID    string `json:"op_id"`
+}
+
+// The reply to a paint that could not be applied
+type liveError struct {
+	Type    string  `json:"type"`
+	OpID    string  `json:"op_id,omitempty"`
+	Problem Problem `json:"problem"`
+}
+
+// Sent before the connection is closed because the event was deleted
+type liveDeleted struct {
+	Type string `json:"type"`
+}
+
+var liveUpgrader = websocket.Upgrader{
+	Error: func(w http.ResponseWriter, r *http.Request, status int, reason error) {
+		writeProblem(w, problemInvalidInput, reason.Error())
+	},
+}
+
+// Live Event Handler
+func liveEvent(w http.ResponseWriter, r *http.Request, eventID string, params api.LiveEventParams) {
+	// A reconnecting client passes the last version it saw
+	since, resume := int64(0), false
+	if params.Since != nil {
+		since, resume = *params.Since, true
+	}
+	mu.Lock()
+	_, exists := events[eventID]
+	mu.Unlock()
+	// If the event does not exist, return a 404 error
+	if !exists {
+		writeProblem(w, problemEventNotFound, "")
+		return
+	}
+
+	ws, err := liveUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	c := &liveConn{send: make(chan interface{}, liveBuffer)}
+	mu.Lock()
+	joined := joinLiveSession(eventID, c, since, resume)
+	mu.Unlock()
+	if !joined {
+		ws.Close()
+		return
+	}
+	go c.writeMessages(ws)
+
+	ws.SetReadLimit(liveMaxMessage)
+	ws.SetReadDeadline(time.Now().Add(livePongTimeout))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(livePongTimeout))
+	})
+	for {
+		var paint livePaint
+		if err := ws.ReadJSON(&paint); err != nil {
+			break
+		}
+		mu.Lock()
+		applyLivePaint(eventID, c, paint)
+		mu.Unlock()
+	}
+	mu.Lock()
+	leaveLiveSession(eventID, c)
+	mu.Unlock()
+}
+
+// Write queued messages to the client until the queue is closed, pinging it
+// while it is idle
+func (c *liveConn) writeMessages(ws *websocket.Conn) {
+	defer ws.Close()
+	ping := time.NewTicker(livePingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case message, ok := <-c.send:
+			ws.SetWriteDeadline(time.Now().Add(liveWriteTimeout))
+			if !ok {
+				ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				return
+			}
+			if err := ws.WriteJSON(message); err != nil {
+				return
+			}
+		case <-ping.C:
+			if err := ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(liveWriteTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Queue a message for a connection, dropping the connection if it has fallen
+// too far behind; the client reconnects and resumes. The caller must hold mu.
+func (session *liveSession) send(c *liveConn, message interface{}) {
+	if _, joined := session.conns[c]; !joined {
+		return
+	}
+	select {
+	case c.send <- message:
+	default:
+		delete(session.conns, c)
+		close(c.send)
+	}
+}
+
+// Add a connection to the event's live session and queue what it needs to
+// catch up: the participants that changed since the version it last saw, or
+// the whole grid. Reports false if the event no longer exists. The caller must
+// hold mu.
+func joinLiveSession(eventID string, c *liveConn, since int64, resume bool) bool {
+	event, exists := events[eventID]
+	if !exists {
+		return false
+	}
+	session, exists := liveSessions[eventID]
+	if !exists {
+		// Versions start from the current time so a version seen before a
+		// restart is never mistaken for one of this session's
+		session = &liveSession{version: time.Now().UnixNano(), conns: map[*liveConn]struct{}{}}
+		liveSessions[eventID] = session
+	}
+	session.conns[c] = struct{}{}
+
+	if changed, ok := session.changedSince(since); resume && ok {
+		for _, participantID := range changed {
+			session.send(c, liveParticipantCells(eventID, event, session.version, participantID))
+		}
+		return true
+	}
+	session.send(c, liveGrid(eventID, event, session.version))
+	return true
+}
+
+// Remove a connection from the event's live session, ending the session when
+// it was the last one. The caller must hold mu.
+func leaveLiveSession(eventID string, c *liveConn) {
+	session, exists := liveSessions[eventID]
+	if !exists {
+		return
+	}
+	if _, joined := session.conns[c]; joined {
+		delete(session.conns, c)
+		close(c.send)
+	}
+	if len(session.conns) == 0 {
+		delete(liveSessions, eventID)
+	}
+}
+
+// List the participants whose availability changed after a version, in the
+// order they changed. Reports false if the changes since then are not all
+// known or include an edit to the event. The caller must hold mu.
+func (session *liveSession) changedSince(since int64) ([]string, bool) {
+	if since > session.version {
+		return nil, false
+	}
+	if since < session.version && (len(session.changes) == 0 || session.changes[0].Version > since+1) {
+		return nil, false
+	}
+	var changed []string
+	for _, change := range session.changes {
+		if change.Version <= since {
+			continue
+		}
+		if change.ParticipantID == "" {
+			return nil, false
+		}
+		if !containsString(changed, change.ParticipantID) {
+			changed = append(changed, change.ParticipantID)
+		}
+	}
+	return changed, true
+}
+
+// Check whether a paint made on an earlier version overlaps a change another
+// client made to the same participant since. The caller must hold mu.
+func (session *liveSession) conflicts(c *liveConn, paint livePaint) bool {
+	if paint.BaseVersion >= session.version {
+		return false
+	}
+	// Changes older than the log may have touched the participant
+	if len(session.changes) == 0 || session.changes[0].Version > paint.BaseVersion+1 {
+		return true
+	}
+	for _, change := range session.changes {
+		if change.Version <= paint.BaseVersion || change.ParticipantID != paint.ParticipantID || change.Conn == c {
+			continue
+		}
+		if change.Cells == nil {
+			return true
+		}
+		for _, cell := range change.Cells {
+			for _, painted := range paint.Cells {
+				if cell.StartTime.Before(painted.EndTime) && cell.EndTime.After(painted.StartTime) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Record a change, keeping the latest liveLogSize, and return its version.
+// The caller must hold mu.
+func (session *liveSession) record(change liveChange) int64 {
+	session.version++
+	change.Version = session.version
+	session.changes = append(session.changes, change)
+	if len(session.changes) > liveLogSize {
+		session.changes = append([]liveChange(nil), session.changes[len(session.changes)-liveLogSize:]...)
+	}
+	return session.version
+}
+
+// Apply a paint from a client, replying with an ack, a conflict or an error.
+// The caller must hold mu.
+func applyLivePaint(eventID string, c *liveConn, paint livePaint) {
+	session, exists := liveSessions[eventID]
+	if !exists {
+		return
+	}
+	reject := func(err error) {
+		session.send(c, liveError{Type: "error", OpID: paint.OpID, Problem: problemDetails(err)})
+	}
+	if paint.Type != "paint" {
+		reject(newProblem(problemInvalidInput, "", FieldError{Field: "type", Message: "type must be paint"}))
+		return
+	}
+	var fieldErrors []FieldError
+	if strings.TrimSpace(paint.ParticipantID) == "" {
+		fieldErrors = append(fieldErrors, FieldError{Field: "participant_id", Message: "participant_id is required"})
+	}
+	if len(paint.Cells) == 0 {
+		fieldErrors = append(fieldErrors, FieldError{Field: "cells", Message: "at least one cell is required"})
+	}
+	for i, cell := range paint.Cells {
+		fieldErrors = append(fieldErrors, validateSlot(fmt.Sprintf("cells[%d].", i), Slot{StartTime: cell.StartTime, EndTime: cell.EndTime})...)
+	}
+	if len(fieldErrors) > 0 {
+		reject(newProblem(problemValidation, "", fieldErrors...))
+		return
+	}
+	event, err := openEvent(eventID)
+	if err != nil {
+		reject(err)
+		return
+	}
+	// Another client changed the same cells since this client last synced:
+	// send the participant's current cells so the client can repaint
+	if session.conflicts(c, paint) {
+		conflict := liveParticipantCells(eventID, event, session.version, paint.ParticipantID)
+		conflict.Type = "conflict"
+		conflict.OpID = paint.OpID
+		session.send(c, conflict)
+		return
+	}
+
+	participant, _ := findParticipant(paint.ParticipantID, eventID)
+	slots := participant.Availability
+	for _, cell := range paint.Cells {
+		cell = Slot{StartTime: cell.StartTime, EndTime: cell.EndTime}
+		if paint.Available {
+			slots = append(append([]Slot(nil), slots...), cell)
+		} else {
+			slots = cutSlots(slots, cell)
+		}
+	}
+	storeParticipantSlots(paint.ParticipantID, event, slots)
+	// Painters show up in the grid as participants of the event
+	if !containsString(event.Participants, paint.ParticipantID) {
+		event = events[eventID]
+		event.Participants = append(append([]string(nil), event.Participants...), paint.ParticipantID)
+		events[eventID] = event
+		publishEvent(eventID)
+	}
+	publishAvailabilityChange(eventID, liveChange{ParticipantID: paint.ParticipantID, Cells: paint.Cells, Conn: c})
+	session.send(c, liveAck{Type: "ack", Version: session.version, OpID: paint.OpID})
+}
+
+// Send a change to a participant's availability to the event's live
+// connections. The caller must hold mu.
+func recordLiveChange(eventID string, change liveChange) {
+	session, exists := liveSessions[eventID]
+	if !exists {
+		return
+	}
+	event := events[eventID]
+	version := session.record(change)
+	message := liveParticipantCells(eventID, event, version, change.ParticipantID)
+	for c := range session.conns {
+		session.send(c, message)
+	}
+}
+
+// Send the whole grid to the event's live connections after the event changed.
+// The caller must hold mu.
+func resetLiveSession(eventID string) {
+	session, exists := liveSessions[eventID]
+	if !exists {
+		return
+	}
+	version := session.record(liveChange{})
+	message := liveGrid(eventID, events[eventID], version)
+	for c := range session.conns {
+		session.send(c, message)
+	}
+}
+
+// Tell the event's live connections it was deleted and close them. The caller
+// must hold mu.
+func closeLiveSession(eventID string) {
+	session, exists := liveSessions[eventID]
+	if !exists {
+		return
+	}
+	for c := range session.conns {
+		session.send(c, liveDeleted{Type: "deleted"})
+		if _, joined := session.conns[c]; joined {
+			delete(session.conns, c)
+			close(c.send)
+		}
+	}
+	delete(liveSessions, eventID)
+}
+
+// Build the grid message for an event. The caller must hold mu.
+func liveGrid(eventID string, event Event, version int64) liveSnapshot {
+	buckets, ok := eventHeatmap(eventID, event, liveCellInterval)
+	if !ok {
+		log.Printf("Event %s: too many cells for a live grid", eventID)
+		buckets = []HeatmapBucket{}
+	}
+	participantIDs := event.Participants
+	if participantIDs == nil {
+		participantIDs = []string{}
+	}
+	return liveSnapshot{Type: "snapshot", Version: version, HeatmapResponse: HeatmapResponse{
+		Interval:     liveCellInterval.String(),
+		Participants: participantIDs,
+		Buckets:      buckets,
+	}}
+}
+
+// Build the message listing the cells a participant is available for. The
+// caller must hold mu.
+func liveParticipantCells(eventID string, event Event, version int64, participantID string) liveParticipant {
+	message := liveParticipant{Type: "participant", Version: version, ParticipantID: participantID, Cells: []Slot{}}
+	buckets, _ := eventHeatmap(eventID, event, liveCellInterval)
+	for _, bucket := range buckets {
+		if containsString(bucket.AvailableParticipants, participantID) {
+			message.Cells = append(message.Cells, Slot{StartTime: bucket.StartTime, EndTime: bucket.EndTime})
+		}
+	}
+	return message
+}
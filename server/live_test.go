@@ -0,0 +1,262 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// A message from a live session, with the fields of every message type
+type liveTestMessage struct {
+	Type          string          `json:"type"`
+	Version       int64           `json:"version"`
+	OpID          string          `json:"op_id"`
+	ParticipantID string          `json:"participant_id"`
+	Cells         []Slot          `json:"cells"`
+	Participants  []string        `json:"participants"`
+	Buckets       []HeatmapBucket `json:"buckets"`
+	Problem       Problem         `json:"problem"`
+}
+
+// Connect to an event's live session
+func dialLive(t *testing.T, server *httptest.Server, eventID string, query string) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/events/" + eventID + "/live" + query
+	ws, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { ws.Close() })
+	return ws
+}
+
+// Read the next message from a live session
+func readLive(t *testing.T, ws *websocket.Conn) liveTestMessage {
+	ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var message liveTestMessage
+	require.NoError(t, ws.ReadJSON(&message))
+	return message
+}
+
+// Paint cells of the first slot, given as half hours from its start
+func paintLive(t *testing.T, ws *websocket.Conn, opID string, participantID string, baseVersion int64, available bool, halfHours ...int) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	var cells []map[string]interface{}
+	for _, n := range halfHours {
+		from := start.Add(time.Duration(n) * 30 * time.Minute)
+		cells = append(cells, map[string]interface{}{"start_time": from, "end_time": from.Add(30 * time.Minute)})
+	}
+	require.NoError(t, ws.WriteJSON(map[string]interface{}{
+		"type":           "paint",
+		"op_id":          opID,
+		"participant_id": participantID,
+		"base_version":   baseVersion,
+		"available":      available,
+		"cells":          cells,
+	}))
+}
+
+func setupLiveEvent(eventID string) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events[eventID] = Event{
+		ID:            eventID,
+		Title:         "Live planning",
+		Slots:         []Slot{{ID: "1", StartTime: start, EndTime: start.Add(2 * time.Hour)}},
+		EstimatedTime: time.Hour,
+		Participants:  []string{"l1", "l2"},
+		lastSlotID:    1,
+	}
+	for _, participantID := range []string{"l1", "l2", "l3"} {
+		removeParticipantAvailability(participantID, eventID)
+	}
+}
+
+func TestLiveEventPainting(t *testing.T) {
+	setupLiveEvent("live")
+	server := httptest.NewServer(setupRouter())
+	defer server.Close()
+
+	alice := dialLive(t, server, "live", "")
+	bob := dialLive(t, server, "live", "")
+	snapshot := readLive(t, alice)
+	assert.Equal(t, "snapshot", snapshot.Type)
+	assert.Equal(t, []string{"l1", "l2"}, snapshot.Participants)
+	assert.Len(t, snapshot.Buckets, 4)
+	assert.Equal(t, snapshot.Version, readLive(t, bob).Version)
+
+	// Everyone sees a paint, and the painter also gets an ack
+	paintLive(t, alice, "a1", "l1", snapshot.Version, true, 0, 1)
+	update := readLive(t, alice)
+	assert.Equal(t, "participant", update.Type)
+	assert.Equal(t, "l1", update.ParticipantID)
+	assert.Len(t, update.Cells, 2)
+	ack := readLive(t, alice)
+	assert.Equal(t, "ack", ack.Type)
+	assert.Equal(t, "a1", ack.OpID)
+	assert.Equal(t, update.Version, ack.Version)
+	assert.Equal(t, update, readLive(t, bob))
+
+	// The paint is stored like any other availability
+	participant, err := schedule.ListParticipantSlots("live", "l1")
+	require.NoError(t, err)
+	assert.Len(t, participant.Availability, 1)
+
+	// Painting a cell unavailable cuts it out
+	paintLive(t, alice, "a2", "l1", ack.Version, false, 0)
+	update = readLive(t, alice)
+	assert.Len(t, update.Cells, 1)
+	assert.Equal(t, "ack", readLive(t, alice).Type)
+	assert.Equal(t, update, readLive(t, bob))
+
+	// Changes made through the REST API reach the session too
+	_, err = schedule.AddParticipantSlot("live", "l2", Slot{StartTime: update.Cells[0].StartTime, EndTime: update.Cells[0].EndTime})
+	require.NoError(t, err)
+	update = readLive(t, bob)
+	assert.Equal(t, "participant", update.Type)
+	assert.Equal(t, "l2", update.ParticipantID)
+	assert.Len(t, update.Cells, 1)
+
+	// Invalid paints are answered with a problem
+	paintLive(t, bob, "b1", "", update.Version, true, 0)
+	rejected := readLive(t, bob)
+	assert.Equal(t, "error", rejected.Type)
+	assert.Equal(t, "b1", rejected.OpID)
+	assert.Equal(t, problemValidation.Type, rejected.Problem.Type)
+	paintLive(t, bob, "b1", "  ", update.Version, true, 0)
+	rejected = readLive(t, bob)
+	assert.Equal(t, "error", rejected.Type)
+	assert.Equal(t, problemValidation.Type, rejected.Problem.Type)
+
+	// New painters join the event's participants
+	paintLive(t, bob, "b2", "l3", update.Version, true, 3)
+	snapshot = readLive(t, bob)
+	assert.Equal(t, "snapshot", snapshot.Type)
+	assert.Equal(t, []string{"l1", "l2", "l3"}, snapshot.Participants)
+	assert.Equal(t, "participant", readLive(t, bob).Type)
+	assert.Equal(t, "ack", readLive(t, bob).Type)
+
+	// Deleting the event ends the session
+	require.NoError(t, schedule.DeleteEvent("live"))
+	for {
+		message := readLive(t, alice)
+		if message.Type == "deleted" {
+			break
+		}
+	}
+	_, _, err = alice.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), "Expected the session to be closed, got %v", err)
+}
+
+func TestLiveEventConflicts(t *testing.T) {
+	setupLiveEvent("live-conflict")
+	server := httptest.NewServer(setupRouter())
+	defer server.Close()
+
+	alice := dialLive(t, server, "live-conflict", "")
+	bob := dialLive(t, server, "live-conflict", "")
+	base := readLive(t, alice).Version
+	readLive(t, bob)
+
+	paintLive(t, alice, "a1", "l1", base, true, 0)
+	readLive(t, alice)
+	readLive(t, alice)
+	readLive(t, bob)
+
+	// Bob has not seen Alice's paint of the same participant's cell
+	paintLive(t, bob, "b1", "l1", base, false, 0)
+	conflict := readLive(t, bob)
+	assert.Equal(t, "conflict", conflict.Type)
+	assert.Equal(t, "b1", conflict.OpID)
+	assert.Equal(t, "l1", conflict.ParticipantID)
+	assert.Len(t, conflict.Cells, 1)
+
+	// Other cells, other participants and the painter's own changes do not conflict
+	paintLive(t, bob, "b2", "l1", base, true, 2)
+	readLive(t, bob)
+	assert.Equal(t, "ack", readLive(t, bob).Type)
+	readLive(t, alice)
+	paintLive(t, bob, "b3", "l2", base, true, 0)
+	readLive(t, bob)
+	assert.Equal(t, "ack", readLive(t, bob).Type)
+	readLive(t, alice)
+	paintLive(t, alice, "a2", "l1", base, false, 0)
+	readLive(t, alice)
+	assert.Equal(t, "ack", readLive(t, alice).Type)
+	latest := readLive(t, bob)
+
+	// Repainting on the latest version succeeds
+	paintLive(t, bob, "b4", "l1", latest.Version, true, 0)
+	readLive(t, bob)
+	assert.Equal(t, "ack", readLive(t, bob).Type)
+}
+
+func TestLiveEventResume(t *testing.T) {
+	setupLiveEvent("live-resume")
+	server := httptest.NewServer(setupRouter())
+	defer server.Close()
+
+	alice := dialLive(t, server, "live-resume", "")
+	seen := readLive(t, alice).Version
+	// Bob stays connected so the session is kept while Alice is away
+	bob := dialLive(t, server, "live-resume", "")
+	readLive(t, bob)
+	alice.Close()
+
+	// Changes made while Alice was away are replayed as participant messages
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	for i, participantID := range []string{"l1", "l2", "l1"} {
+		from := start.Add(time.Duration(i) * 30 * time.Minute)
+		_, err := schedule.AddParticipantSlot("live-resume", participantID, Slot{StartTime: from, EndTime: from.Add(30 * time.Minute)})
+		require.NoError(t, err)
+	}
+	alice = dialLive(t, server, "live-resume", fmt.Sprintf("?since=%d", seen))
+	first, second := readLive(t, alice), readLive(t, alice)
+	assert.Equal(t, "participant", first.Type)
+	assert.Equal(t, "l1", first.ParticipantID)
+	assert.Len(t, first.Cells, 2)
+	assert.Equal(t, "l2", second.ParticipantID)
+	assert.Equal(t, seen+3, second.Version)
+
+	// A version the server does not know gets a snapshot
+	unknown := dialLive(t, server, "live-resume", "?since=1")
+	assert.Equal(t, "snapshot", readLive(t, unknown).Type)
+
+	// So does a version from before an edit to the event
+	_, err := schedule.AddEventSlot("live-resume", Slot{StartTime: time.Date(2025, 1, 14, 14, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 14, 15, 0, 0, 0, time.UTC)})
+	require.NoError(t, err)
+	edited := dialLive(t, server, "live-resume", fmt.Sprintf("?since=%d", second.Version))
+	snapshot := readLive(t, edited)
+	assert.Equal(t, "snapshot", snapshot.Type)
+	assert.Len(t, snapshot.Buckets, 6)
+
+	// The session ends once everyone has left, and resuming it gets a snapshot
+	for _, ws := range []*websocket.Conn{alice, bob, unknown, edited} {
+		ws.Close()
+	}
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		_, exists := liveSessions["live-resume"]
+		return !exists
+	}, 5*time.Second, 10*time.Millisecond, "Expected the session to be removed")
+	resumed := dialLive(t, server, "live-resume", fmt.Sprintf("?since=%d", snapshot.Version))
+	assert.Equal(t, "snapshot", readLive(t, resumed).Type)
+}
+
+func TestLiveEventRejectsPlainRequests(t *testing.T) {
+	setupLiveEvent("live-plain")
+	server := httptest.NewServer(setupRouter())
+	defer server.Close()
+
+	resp, err := server.Client().Get(server.URL + "/v1/events/live-plain/live?since=never")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+	var problem Problem
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+	assert.Equal(t, problemInvalidInput.Type, problem.Type)
+}
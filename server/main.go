@@ -2,27 +2,57 @@ package main
 
 import (
 	"encoding/json"
-	"fmt"
+	"errors"
 	"log"
 	"net/http"
+	"os"
+	"sync"
 	"time"
+	// Zones are checked with time.LoadLocation, and the runtime image has no tzdata
+	_ "time/tzdata"
 
+	"github.com/deepakg86/go-event-scheduler/api"
 	"github.com/gorilla/mux"
+	"github.com/redis/go-redis/v9"
 )
 
 type Slot struct {
-	StartTime time.Time `json:"start_time"`
-	EndTime   time.Time `json:"end_time"`
+	ID         string      `json:"id,omitempty"`
+	StartTime  time.Time   `json:"start_time"`
+	EndTime    time.Time   `json:"end_time"`
+	RRule      string      `json:"rrule,omitempty"`
+	Zone       string      `json:"zone,omitempty"`
+	ExDates    []time.Time `json:"exdates,omitempty"`
+	Preference string      `json:"preference,omitempty"`
+	SlotIDs    []string    `json:"slot_ids,omitempty"`
 }
 
 type Event struct {
-	ID            string        `json:"id"`
-	Title         string        `json:"title"`
-	Slots         []Slot        `json:"slots"`
-	EstimatedTime time.Duration `json:"estimatedTime"`
-	Participants  []string      `json:"participants"`
+	ID               string         `json:"id"`
+	Title            string         `json:"title"`
+	Slots            []Slot         `json:"slots"`
+	EstimatedTime    time.Duration  `json:"estimatedTime"`
+	Participants     []string       `json:"participants"`
+	Organizer        string         `json:"organizer,omitempty"`
+	Horizon          *time.Time     `json:"horizon,omitempty"`
+	Series           *SeriesOptions `json:"series,omitempty"`
+	ResponseDeadline *time.Time     `json:"response_deadline,omitempty"`
+	AutoFinalize     bool           `json:"auto_finalize,omitempty"`
+	Status           string         `json:"status,omitempty"`
+	FinalizedSlot    *Slot          `json:"finalized_slot,omitempty"`
+	AtRisk           bool           `json:"at_risk,omitempty"`
+	Conflicts        []string       `json:"conflicts,omitempty"`
+
+	// lastSlotID is the highest slot ID handed out, so IDs are never reused
+	lastSlotID int
 }
 
+// Event statuses
+const (
+	EventStatusOpen   = "open"
+	EventStatusClosed = "closed"
+)
+
 type Participant struct {
 	ID           string `json:"id"`
 	EventID      string `json:"event_id"`
@@ -35,7 +65,8 @@ type ParticipantAvailability struct {
 }
 
 type AvailabilityResponse struct {
-	RecommendedTimeSlots []SlotUnavailable `json:"recommendedTimeSlots"`
+	RecommendedTimeSlots  []SlotUnavailable      `json:"recommendedTimeSlots"`
+	SeriesRecommendations []SeriesRecommendation `json:"seriesRecommendations,omitempty"`
 }
 
 type SlotUnavailable struct {
@@ -46,318 +77,312 @@ type SlotUnavailable struct {
 var events = make(map[string]Event)
 var participants = make(map[string][]Participant)
 
+// mu guards events and participants, which are shared with the deadline scheduler
+var mu sync.Mutex
+
 // Create Event Handler
 func createEvent(w http.ResponseWriter, r *http.Request) {
 	// Parse the request body to get the event details
-	var event Event
-	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Invalid input"})
+	var body api.CreateEventJSONRequestBody
+	fieldErrors, err := decodeJSON(r.Body, &body)
+	if err != nil {
+		writeProblem(w, problemInvalidInput, "")
+		return
+	}
+	event := eventFromAPI(body)
+	// Report unknown fields along with every other invalid field
+	if len(fieldErrors) > 0 {
+		writeProblem(w, problemValidation, "", append(fieldErrors, validateEvent(event)...)...)
+		return
+	}
+	event, err = schedule.CreateEvent(event)
+	if err != nil {
+		writeError(w, err)
 		return
 	}
-	// Generate a unique ID for the event
-	eventID := fmt.Sprintf("%d", len(events)+1)
-	event.ID = eventID
-	events[eventID] = event
 	// Return a success response
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(map[string]string{"message": "Event created successfully with ID: " + eventID})
+	json.NewEncoder(w).Encode(api.Message{Message: "Event created successfully with ID: " + event.ID})
 }
 
 // Get Event Handler
-func getEvent(w http.ResponseWriter, r *http.Request) {
-	// Extract event_id from the URL parameters
-	params := mux.Vars(r)
-	eventID := params["id"]
-	_, exists := events[eventID]
-	// If the event does not exist, return a 404 error
-	if !exists {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Event not found"})
+func getEvent(w http.ResponseWriter, r *http.Request, eventID string) {
+	event, err := schedule.GetEvent(eventID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	// Return the event as iCalendar if the client asked for it
+	if wantsCalendar(r) {
+		writeEventCalendar(w, event)
 		return
 	}
 	// Return the event
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(events[eventID])
+	json.NewEncoder(w).Encode(apiEvent(event))
 }
 
 // Update Event Handler
-func updateEvent(w http.ResponseWriter, r *http.Request) {
-	// Extract event_id from the URL parameters
-	params := mux.Vars(r)
-	eventID := params["id"]
+func updateEvent(w http.ResponseWriter, r *http.Request, eventID string) {
 	// Parse the request body to get the updated event details
-	var updatedEvent Event
-	err := json.NewDecoder(r.Body).Decode(&updatedEvent)
+	var body api.UpdateEventJSONRequestBody
+	fieldErrors, err := decodeJSON(r.Body, &body)
 	if err != nil {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Invalid input"})
+		writeProblem(w, problemInvalidInput, "")
 		return
 	}
-	event, exists := events[eventID]
-	// If the event does not exist, return a 404 error
-	if !exists {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Event not found"})
+	updatedEvent := eventFromAPI(body)
+	// Report unknown fields along with every other invalid field
+	if len(fieldErrors) > 0 {
+		writeProblem(w, problemValidation, "", append(fieldErrors, validateEvent(updatedEvent)...)...)
+		return
+	}
+	// Replace the event
+	event, err := schedule.UpdateEvent(eventID, updatedEvent)
+	if err != nil {
+		writeError(w, err)
 		return
 	}
-	// Update the event
+	// Return the updated event
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(apiEvent(event))
+}
+
+// Replace every client-writable field of an event, keeping its ID and the
+// fields the server manages. Participants dropped from the event lose their
+// availability for it. The caller must hold mu.
+func replaceEvent(eventID string, updatedEvent Event) {
+	event := events[eventID]
+	for _, participantID := range event.Participants {
+		if !containsString(updatedEvent.Participants, participantID) {
+			removeParticipantAvailability(participantID, eventID)
+		}
+	}
+	previousSlots := event.Slots
 	event.Title = updatedEvent.Title
 	event.Slots = updatedEvent.Slots
+	assignSlotIDs(&event, previousSlots)
 	event.EstimatedTime = updatedEvent.EstimatedTime
+	event.Participants = updatedEvent.Participants
+	event.Organizer = updatedEvent.Organizer
+	event.Horizon = updatedEvent.Horizon
+	event.Series = updatedEvent.Series
+	event.ResponseDeadline = updatedEvent.ResponseDeadline
+	event.AutoFinalize = updatedEvent.AutoFinalize
+	// Reopen an event that closed at its deadline once the deadline is moved
+	// into the future or removed, unless it was finalized meanwhile
+	if event.Status == EventStatusClosed && event.FinalizedSlot == nil && (event.ResponseDeadline == nil || clock.Now().Before(*event.ResponseDeadline)) {
+		event.Status = EventStatusOpen
+	}
 	events[eventID] = event
-	// Return a success response
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{"message": "Event updated successfully"})
+	tagEventAvailability(eventID)
+	// Check the finalized slot still works for everyone
+	revalidateFinalizedSlot(eventID, nil)
+	publishEvent(eventID)
+}
+
+// Remove a participant's availability for an event. The caller must hold mu.
+func removeParticipantAvailability(participantID string, eventID string) {
+	// Build a new list, as availability handed out earlier shares the old one
+	var remaining []Participant
+	for _, participant := range participants[participantID] {
+		if participant.EventID != eventID {
+			remaining = append(remaining, participant)
+		}
+	}
+	participants[participantID] = remaining
 }
 
 // Delete Event Handler
-func deleteEvent(w http.ResponseWriter, r *http.Request) {
-	// Extract event_id from the URL parameters
-	params := mux.Vars(r)
-	eventID := params["id"]
-	_, exists := events[eventID]
-	// If the event does not exist, return a 404 error
-	if !exists {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Event not found"})
+func deleteEvent(w http.ResponseWriter, r *http.Request, eventID string) {
+	if err := schedule.DeleteEvent(eventID); err != nil {
+		writeError(w, err)
 		return
 	}
-	// Delete the event
-	delete(events, eventID)
 	w.WriteHeader(http.StatusNoContent)
 }
 
 // Function to create the availability details of a participant for an event
-func createParticipantAvailability(w http.ResponseWriter, r *http.Request) {
-	// Define the struct to read the request body
-	var availabilityRequest struct {
-		Participant_ID string `json:"participant_id"`
-		EventID        string `json:"event_id"`
-		Slots          []Slot `json:"slots"`
+func createParticipantAvailability(w http.ResponseWriter, r *http.Request, params api.CreateParticipantAvailabilityParams) {
+	// Parse the request body to get the event_id, participant_id, and availability slots,
+	// or an uploaded calendar whose busy time is turned into availability below
+	var body api.CreateParticipantAvailabilityJSONRequestBody
+	var calendar *icsComponent
+	var calendarLoc *time.Location
+	var fieldErrors []FieldError
+	var err error
+	if isCalendarUpload(r) {
+		var uploaded icsComponent
+		body.ParticipantId, body.EventId, uploaded, calendarLoc, err = readCalendarUpload(w, r, params)
+		calendar = &uploaded
+	} else {
+		fieldErrors, err = decodeJSON(r.Body, &body)
+	}
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		writeProblem(w, problemInvalidInput, "The calendar is too large")
+		return
 	}
-	// Parse the request body to get the event_id, participant_id, and availability slots
-	err := json.NewDecoder(r.Body).Decode(&availabilityRequest)
 	if err != nil {
 		// If the input is invalid, return a 400 error
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Invalid input"})
+		writeProblem(w, problemInvalidInput, "")
 		return
 	}
-	if _, exists := events[availabilityRequest.EventID]; !exists {
-		// If the event does not exist, return a 404 error
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Event not found"})
+	slots := availabilityFromAPI(body.Slots)
+	// Validate the availability, reporting every invalid field
+	fieldErrors = append(fieldErrors, validateAvailability(body.ParticipantId, body.EventId, slots)...)
+	if len(fieldErrors) > 0 {
+		writeProblem(w, problemValidation, "", fieldErrors...)
 		return
 	}
-	for _, participant := range participants[availabilityRequest.Participant_ID] {
-		// If the user is already associated with the provided event_id, return a 409 error
-		if participant.EventID == availabilityRequest.EventID {
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusConflict)
-			json.NewEncoder(w).Encode(map[string]string{"message": "This availability has already been recorded"})
+	// Availability from a calendar is the free time within the event's slots
+	if calendar != nil {
+		event, err := schedule.GetEvent(body.EventId)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		slots, err = freeSlotsFromCalendar(*calendar, eventSlots(event), calendarLoc)
+		if err != nil {
+			writeProblem(w, problemInvalidInput, "Invalid calendar: "+err.Error())
 			return
 		}
 	}
-	// Create a new Participant entry for this user and event
-	participant := Participant{
-		ID:           availabilityRequest.Participant_ID,
-		EventID:      availabilityRequest.EventID,
-		Availability: availabilityRequest.Slots,
+	// Store the slots, failing if the participant already responded
+	update, err := schedule.CreateParticipantSlots(body.EventId, body.ParticipantId, slots)
+	if err != nil {
+		writeError(w, err)
+		return
 	}
-	// Add the participant to the event
-	participants[availabilityRequest.Participant_ID] = append(participants[availabilityRequest.Participant_ID], participant)
 	// Respond with success
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{"message": "Availability created successfully"})
+	json.NewEncoder(w).Encode(apiAvailabilityUpdate("Availability created successfully", update))
 }
 
 // Function to get the availability details of a participant for an event
-func getParticipantAvailability(w http.ResponseWriter, r *http.Request) {
-	// Extract participant_id from the URL parameters
-	params := mux.Vars(r)
-	paricipantID := params["participant_id"]
-	// Check if the participant exists in the participants map
-	participant, exists := participants[paricipantID]
-	if !exists {
-		// If the participant does not exist, return a 404 error
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Participant not found"})
+func getParticipantAvailability(w http.ResponseWriter, r *http.Request, participantID string) {
+	availability, err := schedule.GetParticipant(participantID)
+	if err != nil {
+		writeError(w, err)
 		return
 	}
 	// Respond with the participant details and availability
+	response := make([]api.Participant, 0, len(availability))
+	for _, participant := range availability {
+		response = append(response, apiParticipant(participant))
+	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(participant)
+	json.NewEncoder(w).Encode(response)
 }
 
 // Function to update the availability details of a participant for an event
-func updateParticipantAvailability(w http.ResponseWriter, r *http.Request) {
-	// Extract participant_id from the URL parameters
-	params := mux.Vars(r)
-	paricipantID := params["participant_id"]
-	// Define the struct to read the request body
-	var availabilityRequest struct {
-		EventID string `json:"event_id"`
-		Slots   []Slot `json:"slots"`
-	}
-
+func updateParticipantAvailability(w http.ResponseWriter, r *http.Request, participantID string) {
 	// Parse the request body to get the new availability slots and event_id
-	err := json.NewDecoder(r.Body).Decode(&availabilityRequest)
+	var body api.UpdateParticipantAvailabilityJSONRequestBody
+	fieldErrors, err := decodeJSON(r.Body, &body)
 	if err != nil {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Invalid input"})
+		writeProblem(w, problemInvalidInput, "")
 		return
 	}
-
-	// Check if the event exists
-	if _, exists := events[availabilityRequest.EventID]; !exists {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Event not found"})
+	slots := availabilityFromAPI(body.Slots)
+	// Validate the availability, reporting every invalid field
+	fieldErrors = append(fieldErrors, validateAvailability(participantID, body.EventId, slots)...)
+	if len(fieldErrors) > 0 {
+		writeProblem(w, problemValidation, "", fieldErrors...)
 		return
 	}
-	// Check if the participant exists
-	participantFound := false
-	for i, participant := range participants[paricipantID] {
-		// Check if the user is associated with the provided event_id
-		if participant.EventID == availabilityRequest.EventID {
-			// Update the availability slots for the participant
-			participants[paricipantID][i].Availability = availabilityRequest.Slots
-			participantFound = true
-			break
-		}
-	}
-	// If the participant is not found for the specified event_id, return a 404 error
-	if !participantFound {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Participant or event not found"})
+	// Replace the slots, failing if the participant has not responded yet
+	update, err := schedule.UpdateParticipantSlots(body.EventId, participantID, slots)
+	if err != nil {
+		writeError(w, err)
 		return
 	}
 	// Respond with the updated participant availability
+	writeAvailabilityUpdate(w, http.StatusOK, update)
+}
+
+// Function to delete the availability details of a participant for an event
+func deleteParticipantAvailability(w http.ResponseWriter, r *http.Request, eventID string, participantID string) {
+	if err := schedule.DeleteParticipant(eventID, participantID); err != nil {
+		writeError(w, err)
+		return
+	}
+	// Respond with success
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{"message": "Availability updated successfully"})
+	json.NewEncoder(w).Encode(api.Message{Message: "All slots for this event deleted successfully"})
 }
 
-// Function to delete the availability details of a participant for an event
-func deleteParticipantAvailability(w http.ResponseWriter, r *http.Request) {
-	// Extract participant_id and event_id from the URL parameters
-	params := mux.Vars(r)
-	participantID := params["participant_id"]
-	eventID := params["event_id"]
-
-	event, exists := events[eventID]
-	// Check if the event exists
-	if !exists {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Event not found"})
+// Find common slots for the event based on its participants' availability
+func findCommonSlots(w http.ResponseWriter, r *http.Request, eventID string) {
+	response, err := schedule.FindCommonSlots(eventID)
+	if err != nil {
+		writeError(w, err)
 		return
 	}
-	// Check if the user has availability for the event
-	participantFound := false
-	for i, participant := range participants[participantID] {
-		if participant.EventID == eventID {
-			// If the user is found for the event, remove all their slots for that event
-			participantFound = true
-			// Remove the participant from the participants map for that event
-			participants[participantID] = append(participants[participantID][:i], participants[participantID][i+1:]...)
-			// Also remove the participant from the event's Participants list (since event is a value, not a pointer)
-			for j, pid := range event.Participants {
-				if pid == participantID {
-					// Remove the participant from the event's Participants list
-					event.Participants = append(event.Participants[:j], event.Participants[j+1:]...)
-					break
-				}
-			}
+	// Respond with the recommended time slots and unavailable participants
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(apiAvailabilityResponse(response))
+}
 
-			// Reassign the updated event back to the map
-			events[eventID] = event
+// Helper function to get a participant's availability for an event
+func getEventAvailability(participantID string, eventID string) ParticipantAvailability {
+	return expandAvailability(participantID, eventID, availabilityHorizon(events[eventID]))
+}
 
-			// Respond with success
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusOK)
-			json.NewEncoder(w).Encode(map[string]string{"message": "All slots for this event deleted successfully"})
-			return
+// Helper function to get a participant's availability for an event, with
+// recurring availability expanded up to horizon
+func expandAvailability(participantID string, eventID string, horizon time.Time) ParticipantAvailability {
+	for _, participant := range participants[participantID] {
+		if participant.EventID == eventID {
+			return ParticipantAvailability{
+				Participant_ID: participantID,
+				Slots:          expandSlots(participant.Availability, horizon),
+			}
 		}
 	}
-
-	// If the user is not found for the event, return 404
-	if !participantFound {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Participant or event not found"})
-		return
-	}
+	return ParticipantAvailability{Participant_ID: participantID}
 }
 
-// Helper function to check if an event slot works for a user
-func isSlotAvailableForUser(eventSlot Slot, participantAvailability ParticipantAvailability) bool {
-	for _, userSlot := range participantAvailability.Slots {
-		// Check if the user's slot overlaps with the event's slot
-		if eventSlot.StartTime.Before(userSlot.EndTime) && eventSlot.EndTime.After(userSlot.StartTime) {
-			// If there's an overlap (even partial), the user is considered available
-			return true
-		}
+// Expand the availability of each of the event's participants once, merged
+// into free blocks, so every slot can be checked against it without expanding
+// it again. The caller must hold mu.
+func eventAvailability(eventID string, event Event) map[string]ParticipantAvailability {
+	horizon := availabilityHorizon(event)
+	availability := make(map[string]ParticipantAvailability, len(event.Participants))
+	for _, participantID := range event.Participants {
+		expanded := expandAvailability(participantID, eventID, horizon)
+		expanded.Slots = mergeSlots(expanded.Slots)
+		availability[participantID] = expanded
 	}
-	// No overlap, so the user is unavailable
-	return false
+	return availability
 }
 
-// Find common slots for the event based on its participants' availability
-func findCommonSlots(w http.ResponseWriter, r *http.Request) {
-	params := mux.Vars(r)
-	eventID := params["id"]
-
-	event, exists := events[eventID]
-	// Check if the event exists
-	if !exists {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		json.NewEncoder(w).Encode(map[string]string{"message": "Event not found"})
-		return
+// Recommend the event slots that work for all participants, or failing that,
+// the slots that work for the most participants along with who is unavailable.
+// availability is the participants' expanded availability from
+// eventAvailability.
+func recommendSlots(event Event, availability map[string]ParticipantAvailability) []SlotUnavailable {
+	// A recurring meeting needs a time that works across the whole series
+	if event.Series != nil {
+		return recommendSeriesSlots(event, recommendSeries(event, availability))
 	}
 
-	// Prepare the response structure
 	var recommendedTimeSlots []SlotUnavailable
 	var maxParticipants int
 	var bestSlots []Slot
 
-	// Process each slot for the current event (Event 1)
-	for _, eventSlot := range event.Slots {
+	// Process each slot for the event, with recurring slots expanded
+	for _, eventSlot := range eventSlots(event) {
 		var availableParticipants []string
-		// Get participants for this specific event
 		for _, paricipantID := range event.Participants {
-			// Get the list of participants for this event
-			var participantAvailability ParticipantAvailability
-			for _, participant := range participants[paricipantID] {
-				if participant.EventID == eventID {
-					// Found the participant for this event
-					participantAvailability = ParticipantAvailability{
-						Participant_ID: paricipantID,
-						Slots:          participant.Availability,
-					}
-					break
-				}
-			}
-
 			// Check if user is available for the event slot
-			if isSlotAvailableForUser(eventSlot, participantAvailability) {
+			if attendsSlot(eventSlot, event.EstimatedTime, availability[paricipantID]) {
 				availableParticipants = append(availableParticipants, paricipantID)
 			}
 		}
@@ -384,20 +409,7 @@ func findCommonSlots(w http.ResponseWriter, r *http.Request) {
 			var unavailable []string
 			// For each of the best slots, find unavailable participants
 			for _, paricipantID := range event.Participants {
-				var participantAvailability ParticipantAvailability
-				// Get user availability for the current event
-				for _, participant := range participants[paricipantID] {
-					if participant.EventID == eventID {
-						participantAvailability = ParticipantAvailability{
-							Participant_ID: paricipantID,
-							Slots:          participant.Availability,
-						}
-						break
-					}
-				}
-
-				// If user is unavailable, add to the list of unavailable participants
-				if !isSlotAvailableForUser(eventSlot, participantAvailability) {
+				if !attendsSlot(eventSlot, event.EstimatedTime, availability[paricipantID]) {
 					unavailable = append(unavailable, paricipantID)
 				}
 			}
@@ -412,31 +424,21 @@ func findCommonSlots(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Respond with the recommended time slots and unavailable participants
-	response := AvailabilityResponse{
-		RecommendedTimeSlots: recommendedTimeSlots,
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	return recommendedTimeSlots
 }
 
 func main() {
 	router := mux.NewRouter()
+	registerRoutes(router)
 
-	// Event Routes
-	router.HandleFunc("/event", createEvent).Methods("POST")
-	router.HandleFunc("/events/{id}", getEvent).Methods("GET")
-	router.HandleFunc("/event/{id}", updateEvent).Methods("PUT")
-	router.HandleFunc("/event/{id}", deleteEvent).Methods("DELETE")
-
-	// User Availability Routes
-	router.HandleFunc("/participant", createParticipantAvailability).Methods("POST") // Get possible slots for the event
-	router.HandleFunc("/participant/{participant_id}", getParticipantAvailability).Methods("GET")
-	router.HandleFunc("/participant/{participant_id}", updateParticipantAvailability).Methods("PUT")
-	router.HandleFunc("/participant/{participant_id}/event/{event_id}", deleteParticipantAvailability).Methods("DELETE")
-
-	router.HandleFunc("/event/{id}/find-common-slots", findCommonSlots).Methods("GET")
+	// Share event updates with the other replicas' streams
+	if addr := os.Getenv("REDIS_ADDR"); addr != "" {
+		broker = newRedisBroker(redis.NewClient(&redis.Options{Addr: addr}))
+	}
+	// Close events whose response deadline has passed
+	go startDeadlineScheduler(time.Minute, nil)
+	// Serve the gRPC API alongside the REST API
+	go serveGRPC(":9090")
 
 	log.Println("Server started at :8080")
 	log.Fatal(http.ListenAndServe(":8080", router))
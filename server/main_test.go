@@ -14,22 +14,7 @@ import (
 
 func setupRouter() *mux.Router {
 	router := mux.NewRouter()
-
-	// Event Routes
-	router.HandleFunc("/event", createEvent).Methods("POST")
-	router.HandleFunc("/events/{id}", getEvent).Methods("GET")
-	router.HandleFunc("/event/{id}", updateEvent).Methods("PUT")
-	router.HandleFunc("/event/{id}", deleteEvent).Methods("DELETE")
-
-	// Participant Availability Routes
-	router.HandleFunc("/participant", createParticipantAvailability).Methods("POST")
-	router.HandleFunc("/participant/{participant_id}", getParticipantAvailability).Methods("GET")
-	router.HandleFunc("/participant/{participant_id}", updateParticipantAvailability).Methods("PUT")
-	router.HandleFunc("/participant/{participant_id}/event/{event_id}", deleteParticipantAvailability).Methods("DELETE")
-
-	// Find common slots
-	router.HandleFunc("/event/{id}/find-common-slots", findCommonSlots).Methods("GET")
-
+	registerRoutes(router)
 	return router
 }
 
@@ -55,6 +40,29 @@ func TestCreateEvent(t *testing.T) {
 	assert.Equal(t, http.StatusCreated, rr.Code, "Expected status code 201")
 }
 
+func TestCreatedEventIDsAreNotReused(t *testing.T) {
+	event := Event{
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: time.Now(), EndTime: time.Now().Add(1 * time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+	}
+	first, err := schedule.CreateEvent(event)
+	assert.NoError(t, err)
+	second, err := schedule.CreateEvent(event)
+	assert.NoError(t, err)
+	assert.NoError(t, schedule.DeleteEvent(first.ID))
+
+	// A new event never takes the ID of one that still exists or was deleted,
+	// so calendar clients never mistake it for the old event by its UID
+	third, err := schedule.CreateEvent(event)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.ID, third.ID)
+	assert.NotEqual(t, second.ID, third.ID)
+	stored, err := schedule.GetEvent(second.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, second.ID, stored.ID)
+}
+
 func TestGetEvent(t *testing.T) {
 	// Set up the router
 	router := setupRouter()
@@ -128,7 +136,7 @@ func TestUpdateParticipantAvailability(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
 
 	// Check if participant's availability was updated
-	var response map[string]string
+	var response map[string]interface{}
 	err = json.NewDecoder(rr.Body).Decode(&response)
 	if err != nil {
 		t.Fatalf("could not decode response: %v", err)
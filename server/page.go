@@ -0,0 +1,160 @@
+package main
+
+import (
+	"embed"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// Size of a cell in the availability page grid
+const pageCellInterval = 30 * time.Minute
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+var pageTemplates = template.Must(template.ParseFS(templateFS, "templates/*.html"))
+
+type availabilityPage struct {
+	Event         Event
+	ParticipantID string
+	Zone          string
+	Message       string
+	Error         bool
+	FieldErrors   []FieldError
+	Days          []pageDay
+}
+
+type pageDay struct {
+	Label string
+	Cells []pageCell
+}
+
+type pageCell struct {
+	Label   string
+	Value   string
+	Checked bool
+	Others  int
+}
+
+// Availability Page Handler
+func getAvailabilityPage(w http.ResponseWriter, r *http.Request, eventID string, params api.GetAvailabilityPageParams) {
+	message := ""
+	if params.Saved != "" {
+		message = "Your availability has been saved."
+	}
+	renderAvailabilityPage(w, http.StatusOK, eventID, params.ParticipantId, params.Zone, message, false)
+}
+
+// Availability Page Form Handler. The checked cells become available for the
+// participant and the unchecked ones unavailable; the rest of their
+// availability for the event is kept.
+func submitAvailabilityPage(w http.ResponseWriter, r *http.Request, eventID string) {
+	if err := r.ParseForm(); err != nil {
+		renderAvailabilityPage(w, http.StatusBadRequest, eventID, "", "", "Invalid input", true)
+		return
+	}
+	participantID := r.PostForm.Get("participant_id")
+	zone := r.PostForm.Get("zone")
+	if participantID == "" {
+		renderAvailabilityPage(w, http.StatusBadRequest, eventID, "", zone, "Please enter your name", true)
+		return
+	}
+	var slots []Slot
+	for _, value := range r.PostForm["slot"] {
+		slot, err := parsePageCellValue(value)
+		if err != nil {
+			renderAvailabilityPage(w, http.StatusBadRequest, eventID, participantID, zone, "Invalid input", true)
+			return
+		}
+		slots = append(slots, slot)
+	}
+
+	// Create the availability the first time, and change only the painted
+	// cells after that
+	if _, err := schedule.SetParticipantCells(eventID, participantID, pageCellInterval, slots); err != nil {
+		problem := problemDetails(err)
+		renderAvailabilityPage(w, problem.Status, eventID, participantID, zone, err.Error(), true, problem.Errors...)
+		return
+	}
+	// Redirect so refreshing the page does not resubmit the form
+	redirect := url.Values{"participant_id": {participantID}, "zone": {zone}, "saved": {"1"}}
+	http.Redirect(w, r, "/events/"+url.PathEscape(eventID)+"/availability?"+redirect.Encode(), http.StatusSeeOther)
+}
+
+// Render the availability grid for the participant, with cells they are
+// available for checked and a count of everyone else available in each cell.
+// Field errors are listed under the message.
+func renderAvailabilityPage(w http.ResponseWriter, status int, eventID string, participantID string, zone string, message string, isError bool, fieldErrors ...FieldError) {
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		loc, zone = time.UTC, "UTC"
+	}
+	if zone == "" {
+		zone = "UTC"
+	}
+
+	event, buckets, own, exists := availabilityPageGrid(eventID, participantID)
+	// If the event does not exist, return a 404 error
+	if !exists {
+		writeProblem(w, problemEventNotFound, "")
+		return
+	}
+
+	page := availabilityPage{Event: event, ParticipantID: participantID, Zone: zone, Message: message, Error: isError, FieldErrors: fieldErrors}
+	for _, bucket := range buckets {
+		start := bucket.StartTime.In(loc)
+		label := start.Format("Mon 2 Jan 2006")
+		if len(page.Days) == 0 || page.Days[len(page.Days)-1].Label != label {
+			page.Days = append(page.Days, pageDay{Label: label})
+		}
+		others := bucket.Count
+		if containsString(bucket.AvailableParticipants, participantID) {
+			others--
+		}
+		day := &page.Days[len(page.Days)-1]
+		day.Cells = append(day.Cells, pageCell{
+			Label:   start.Format("15:04") + " - " + bucket.EndTime.In(loc).Format("15:04"),
+			Value:   bucket.StartTime.UTC().Format(time.RFC3339) + "/" + bucket.EndTime.UTC().Format(time.RFC3339),
+			Checked: coversSlot(own, Slot{StartTime: bucket.StartTime, EndTime: bucket.EndTime}),
+			Others:  others,
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	pageTemplates.ExecuteTemplate(w, "availability.html", page)
+}
+
+// Get the event, its grid cells and the participant's merged availability.
+// Reports false if the event does not exist.
+func availabilityPageGrid(eventID string, participantID string) (Event, []HeatmapBucket, []Slot, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, exists := events[eventID]
+	if !exists {
+		return Event{}, nil, nil, false
+	}
+	buckets, _ := eventHeatmap(eventID, event, pageCellInterval)
+	own := mergeSlots(getEventAvailability(participantID, eventID).Slots)
+	return event, buckets, own, true
+}
+
+// Parse a grid cell value of the form start/end
+func parsePageCellValue(value string) (Slot, error) {
+	startValue, endValue, _ := strings.Cut(value, "/")
+	start, err := time.Parse(time.RFC3339, startValue)
+	if err != nil {
+		return Slot{}, err
+	}
+	end, err := time.Parse(time.RFC3339, endValue)
+	if err != nil {
+		return Slot{}, err
+	}
+	return Slot{StartTime: start, EndTime: end}, nil
+}
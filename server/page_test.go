@@ -0,0 +1,159 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvailabilityPageSubmit(t *testing.T) {
+	router := setupRouter()
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["page"] = Event{
+		ID:            "page",
+		Title:         "Team <offsite>",
+		Slots:         []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+	}
+	delete(participants, "pat")
+
+	// The grid shows one cell per half hour, with the title escaped
+	req, err := http.NewRequest("GET", "/events/page/availability?participant_id=pat", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	assert.Contains(t, rr.Body.String(), "Team &lt;offsite&gt;")
+	assert.Equal(t, 2, strings.Count(rr.Body.String(), `type="checkbox"`))
+
+	// Painting both cells records a single merged slot
+	submit := func(cells ...string) *httptest.ResponseRecorder {
+		form := url.Values{"participant_id": {"pat"}, "slot": cells}
+		req, err := http.NewRequest("POST", "/events/page/availability", strings.NewReader(form.Encode()))
+		if err != nil {
+			t.Fatalf("could not create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+	first := "2025-01-13T14:00:00Z/2025-01-13T14:30:00Z"
+	second := "2025-01-13T14:30:00Z/2025-01-13T15:00:00Z"
+
+	rr = submit(first, second)
+	assert.Equal(t, http.StatusSeeOther, rr.Code, "Expected status code 303")
+	if assert.Len(t, participants["pat"], 1) {
+		assert.Equal(t, []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}}, participants["pat"][0].Availability)
+	}
+
+	// Submitting again updates the existing availability
+	rr = submit(second)
+	assert.Equal(t, http.StatusSeeOther, rr.Code, "Expected status code 303")
+	if assert.Len(t, participants["pat"], 1) {
+		assert.Equal(t, []Slot{{StartTime: start.Add(30 * time.Minute), EndTime: start.Add(time.Hour)}}, participants["pat"][0].Availability)
+	}
+}
+
+// Two first submissions at once both save, rather than one racing into a conflict
+func TestAvailabilityPageConcurrentSubmit(t *testing.T) {
+	router := setupRouter()
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["page-race"] = Event{
+		ID:            "page-race",
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+	}
+	delete(participants, "pam")
+
+	codes := make(chan int, 8)
+	for i := 0; i < cap(codes); i++ {
+		go func() {
+			form := url.Values{"participant_id": {"pam"}, "slot": {"2025-01-13T14:00:00Z/2025-01-13T14:30:00Z"}}
+			req := httptest.NewRequest("POST", "/events/page-race/availability", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+			codes <- rr.Code
+		}()
+	}
+	for i := 0; i < cap(codes); i++ {
+		assert.Equal(t, http.StatusSeeOther, <-codes, "Expected status code 303")
+	}
+	participant, err := schedule.ListParticipantSlots("page-race", "pam")
+	assert.NoError(t, err)
+	assert.Len(t, participant.Availability, 1)
+}
+
+// Saving the form changes only the painted cells, keeping the rest of the
+// participant's availability
+func TestAvailabilityPageSubmitKeepsOtherAvailability(t *testing.T) {
+	router := setupRouter()
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["page-keep"] = Event{
+		ID:            "page-keep",
+		Title:         "Test Event",
+		Slots:         []Slot{{ID: "1", StartTime: start, EndTime: start.Add(2 * time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+	}
+	recurring := Slot{StartTime: start.Add(-24 * time.Hour), EndTime: start.Add(-23*time.Hour - 30*time.Minute), RRule: "FREQ=DAILY;COUNT=3"}
+	preferred := Slot{StartTime: start.Add(30 * time.Minute), EndTime: start.Add(time.Hour), Preference: "preferred"}
+	partial := Slot{StartTime: start.Add(90 * time.Minute), EndTime: start.Add(100 * time.Minute)}
+	participants["pia"] = []Participant{{ID: "pia", EventID: "page-keep", Availability: []Slot{recurring, preferred, partial}}}
+
+	// The recurring slot covers the first cell and the preferred slot the
+	// second. Leaving the first unchecked keeps the recurring slot, and
+	// painting the third keeps the partial slot in the fourth.
+	form := url.Values{"participant_id": {"pia"}, "slot": {
+		"2025-01-13T14:30:00Z/2025-01-13T15:00:00Z",
+		"2025-01-13T15:00:00Z/2025-01-13T15:30:00Z",
+	}}
+	req, err := http.NewRequest("POST", "/events/page-keep/availability", strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusSeeOther, rr.Code, "Expected status code 303")
+
+	participant, err := schedule.ListParticipantSlots("page-keep", "pia")
+	assert.NoError(t, err)
+	assert.Equal(t, []Slot{
+		{StartTime: recurring.StartTime, EndTime: recurring.EndTime, RRule: recurring.RRule, SlotIDs: []string{"1"}},
+		{StartTime: preferred.StartTime, EndTime: preferred.EndTime, Preference: "preferred", SlotIDs: []string{"1"}},
+		// The painted cell merges with the partial availability after it
+		{StartTime: start.Add(time.Hour), EndTime: partial.EndTime, SlotIDs: []string{"1"}},
+	}, participant.Availability)
+}
+
+// A rejected form lists the invalid fields rather than only the problem title
+func TestAvailabilityPageSubmitFieldErrors(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["page-invalid"] = Event{
+		ID:            "page-invalid",
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+	}
+	form := url.Values{"participant_id": {"pix"}, "slot": {"2025-01-13T14:30:00Z/2025-01-13T14:00:00Z"}}
+	req, err := http.NewRequest("POST", "/events/page-invalid/availability", strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+	setupRouter().ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, "Expected status code 422")
+	assert.Contains(t, rr.Body.String(), "<li>slot[0].end_time: end_time must be after start_time</li>")
+}
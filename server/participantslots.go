@@ -0,0 +1,165 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// Participant Slots Handler
+func listParticipantSlots(w http.ResponseWriter, r *http.Request, eventID string, participantID string) {
+	participant, err := schedule.ListParticipantSlots(eventID, participantID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	// Return the participant's availability for the event
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(apiParticipant(participant))
+}
+
+// Replace Participant Slots Handler. Creates the participant's availability
+// for the event if they have not responded yet.
+func replaceParticipantSlots(w http.ResponseWriter, r *http.Request, eventID string, participantID string) {
+	// Parse the request body to get the full list of slots
+	var body api.ReplaceParticipantSlotsJSONRequestBody
+	fieldErrors, err := decodeJSON(r.Body, &body)
+	if err != nil {
+		writeProblem(w, problemInvalidInput, "")
+		return
+	}
+	slots := availabilityFromAPI(body)
+	// Report unknown fields along with every other invalid field
+	if len(fieldErrors) > 0 {
+		writeProblem(w, problemValidation, "", append(fieldErrors, validateSlots("", slots)...)...)
+		return
+	}
+	update, err := schedule.ReplaceParticipantSlots(eventID, participantID, slots)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	status := http.StatusOK
+	if update.Created {
+		status = http.StatusCreated
+	}
+	writeAvailabilityUpdate(w, status, update)
+}
+
+// Add Participant Slot Handler. The slot is merged into the participant's
+// availability for the event.
+func addParticipantSlot(w http.ResponseWriter, r *http.Request, eventID string, participantID string) {
+	// Parse the request body to get the slot to add
+	var body api.AddParticipantSlotJSONRequestBody
+	fieldErrors, err := decodeJSON(r.Body, &body)
+	if err != nil {
+		writeProblem(w, problemInvalidInput, "")
+		return
+	}
+	slot := availabilitySlotFromAPI(body)
+	// Report unknown fields along with every other invalid field
+	if len(fieldErrors) > 0 {
+		writeProblem(w, problemValidation, "", append(fieldErrors, validateSlot("", slot)...)...)
+		return
+	}
+	update, err := schedule.AddParticipantSlot(eventID, participantID, slot)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeAvailabilityUpdate(w, http.StatusCreated, update)
+}
+
+// Remove Participant Slots Handler. The time between the start_time and
+// end_time query parameters is cut out of the participant's one-off slots.
+func removeParticipantSlots(w http.ResponseWriter, r *http.Request, eventID string, participantID string, params api.RemoveParticipantSlotsParams) {
+	block := Slot{StartTime: params.StartTime, EndTime: params.EndTime}
+	update, err := schedule.RemoveParticipantSlots(eventID, participantID, block)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeAvailabilityUpdate(w, http.StatusOK, update)
+}
+
+// Get the event if it exists and is accepting responses. The caller must hold
+// mu.
+func openEvent(eventID string) (Event, error) {
+	event, exists := events[eventID]
+	if !exists {
+		return Event{}, newProblem(problemEventNotFound, "")
+	}
+	if isEventClosed(event) {
+		return Event{}, newProblem(problemEventClosed, "")
+	}
+	return event, nil
+}
+
+// Find a participant's availability for an event. The caller must hold mu.
+func findParticipant(participantID string, eventID string) (Participant, bool) {
+	for _, participant := range participants[participantID] {
+		if participant.EventID == eventID {
+			return participant, true
+		}
+	}
+	return Participant{ID: participantID, EventID: eventID}, false
+}
+
+// Normalize and store a participant's availability for an event, creating it
+// if needed. The caller must hold mu and publish the change.
+func storeParticipantSlots(participantID string, event Event, slots []Slot) availabilityUpdate {
+	slots, normalization := normalizeAvailability(slots, event)
+	found := false
+	for i, participant := range participants[participantID] {
+		if participant.EventID == event.ID {
+			// Copy the list, as availability handed out earlier shares it
+			updated := append([]Participant(nil), participants[participantID]...)
+			updated[i].Availability = slots
+			participants[participantID] = updated
+			found = true
+			break
+		}
+	}
+	if !found {
+		participants[participantID] = append(participants[participantID], Participant{
+			ID:           participantID,
+			EventID:      event.ID,
+			Availability: slots,
+		})
+	}
+	// Check the finalized slot still works for everyone
+	revalidateFinalizedSlot(event.ID, nil)
+	return availabilityUpdate{Availability: slots, Normalized: normalization, Created: !found}
+}
+
+// Respond with a participant's stored availability
+func writeAvailabilityUpdate(w http.ResponseWriter, status int, update availabilityUpdate) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(apiAvailabilityUpdate("Availability updated successfully", update))
+}
+
+// Cut a block of time out of the one-off slots, keeping each slot's other
+// fields. Recurring slots are kept as they are.
+func cutSlots(slots []Slot, block Slot) []Slot {
+	var remaining []Slot
+	for _, slot := range slots {
+		if slot.RRule != "" || !slot.StartTime.Before(block.EndTime) || !slot.EndTime.After(block.StartTime) {
+			remaining = append(remaining, slot)
+			continue
+		}
+		if slot.StartTime.Before(block.StartTime) {
+			before := slot
+			before.EndTime = block.StartTime
+			remaining = append(remaining, before)
+		}
+		if slot.EndTime.After(block.EndTime) {
+			after := slot
+			after.StartTime = block.EndTime
+			remaining = append(remaining, after)
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParticipantSlots(t *testing.T) {
+	router := setupRouter()
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["toggle"] = Event{
+		ID:            "toggle",
+		Title:         "Test Event",
+		Slots:         []Slot{{ID: "1", StartTime: start, EndTime: start.Add(4 * time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"t1"},
+	}
+	send := func(method string, path string, body interface{}) *httptest.ResponseRecorder {
+		var b []byte
+		if body != nil {
+			b, _ = json.Marshal(body)
+		}
+		req, err := http.NewRequest(method, path, bytes.NewReader(b))
+		if err != nil {
+			t.Fatalf("could not create request: %v", err)
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+	stored := func() []Slot {
+		participant, _ := findParticipant("t1", "toggle")
+		return participant.Availability
+	}
+
+	// Toggle on 2-3PM, then 3-4PM, which merge
+	rr := send("POST", "/event/toggle/participants/t1/slots", Slot{StartTime: start, EndTime: start.Add(time.Hour)})
+	assert.Equal(t, http.StatusCreated, rr.Code, "Expected status code 201")
+	rr = send("POST", "/event/toggle/participants/t1/slots", Slot{StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour)})
+	assert.Equal(t, http.StatusCreated, rr.Code, "Expected status code 201")
+	assert.Equal(t, []Slot{{StartTime: start, EndTime: start.Add(2 * time.Hour), SlotIDs: []string{"1"}}}, stored())
+
+	// Toggle off 2:30-3PM
+	rr = send("DELETE", "/event/toggle/participants/t1/slots?start_time=2025-01-13T14:30:00Z&end_time=2025-01-13T15:00:00Z", nil)
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	assert.Equal(t, []Slot{
+		{StartTime: start, EndTime: start.Add(30 * time.Minute), SlotIDs: []string{"1"}},
+		{StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour), SlotIDs: []string{"1"}},
+	}, stored())
+
+	// Replace the whole list
+	rr = send("PUT", "/event/toggle/participants/t1/slots", []Slot{{StartTime: start.Add(3 * time.Hour), EndTime: start.Add(4 * time.Hour)}})
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+
+	rr = send("GET", "/event/toggle/participants/t1/slots", nil)
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	var participant Participant
+	json.NewDecoder(rr.Body).Decode(&participant)
+	assert.Equal(t, "toggle", participant.EventID)
+	assert.Len(t, participant.Availability, 1)
+
+	// Remove the participant's availability
+	rr = send("DELETE", "/event/toggle/participants/t1", nil)
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	rr = send("GET", "/event/toggle/participants/t1/slots", nil)
+	assert.Equal(t, http.StatusNotFound, rr.Code, "Expected status code 404")
+}
+
+func TestRemoveParticipantSlotsInvalidRange(t *testing.T) {
+	router := setupRouter()
+
+	req, err := http.NewRequest("DELETE", "/event/toggle/participants/t1/slots?start_time=2025-01-13T15:00:00Z", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code, "Expected status code 400")
+
+	// A block that ends before it starts is a validation problem
+	req, err = http.NewRequest("DELETE", "/event/toggle/participants/t1/slots?start_time=2025-01-13T15:00:00Z&end_time=2025-01-13T14:00:00Z", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, "Expected status code 422")
+	assert.Contains(t, rr.Body.String(), "end_time must be after start_time")
+}
+
+// Events and availability handed out are not changed by later writes, so
+// handlers can encode them after releasing mu. Run with -race.
+func TestDeleteParticipantDoesNotChangeEarlierReads(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	ids := []string{"r1", "r2", "r3", "r4"}
+	events["race"] = Event{
+		ID:            "race",
+		Title:         "Race meeting",
+		Slots:         []Slot{{ID: "1", StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime: time.Hour,
+		Participants:  append([]string(nil), ids...),
+	}
+	for _, participantID := range ids {
+		participants[participantID] = []Participant{{ID: participantID, EventID: "race", Availability: []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}}}}
+	}
+
+	event, err := schedule.GetEvent("race")
+	assert.NoError(t, err)
+	availability, err := schedule.GetParticipant("r4")
+	assert.NoError(t, err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, participantID := range ids {
+			assert.NoError(t, schedule.DeleteParticipant("race", participantID))
+		}
+	}()
+	encoded, _ := json.Marshal(event)
+	_, _ = json.Marshal(availability)
+	<-done
+
+	assert.Contains(t, string(encoded), `"participants":["r1","r2","r3","r4"]`)
+	assert.Equal(t, []string{"r1", "r2", "r3", "r4"}, event.Participants)
+	assert.Equal(t, "race", availability[0].EventID)
+	updated, _ := schedule.GetEvent("race")
+	assert.Empty(t, updated.Participants)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net/http"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// Media type of an RFC 7396 JSON merge patch
+const mergePatchContentType = "application/merge-patch+json"
+
+// Event fields the server manages, which a patch may not change
+var readOnlyEventFields = []string{"id", "status", "finalized_slot", "at_risk", "conflicts"}
+
+// Patch Event Handler. The body is a JSON merge patch applied to the event's
+// JSON form, as described on scheduler.PatchEvent.
+func patchEvent(w http.ResponseWriter, r *http.Request, eventID string) {
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, _ := mime.ParseMediaType(contentType)
+		if mediaType != mergePatchContentType && mediaType != "application/json" {
+			writeProblem(w, problemUnsupportedMedia, "Send the patch as "+mergePatchContentType)
+			return
+		}
+	}
+	// Parse the patch, which must be a JSON object
+	var patch map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil || patch == nil {
+		writeProblem(w, problemInvalidInput, "The patch must be a JSON object")
+		return
+	}
+	event, err := schedule.PatchEvent(eventID, patch)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	// Return the patched event
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(apiEvent(event))
+}
+
+// Apply a merge patch to the event's JSON form, its API model, and read the
+// result back, reporting fields the patch added that an event does not have
+func applyEventPatch(event Event, patch map[string]interface{}) (Event, []FieldError, error) {
+	current, _ := json.Marshal(apiEvent(event))
+	var document interface{}
+	json.Unmarshal(current, &document)
+	patched, _ := json.Marshal(mergePatch(document, patch))
+	var patchedEvent api.Event
+	fieldErrors, err := decodeJSON(bytes.NewReader(patched), &patchedEvent)
+	return eventFromAPI(patchedEvent), fieldErrors, err
+}
+
+// Apply an RFC 7396 merge patch to a JSON document
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+			continue
+		}
+		targetObject[name] = mergePatch(targetObject[name], value)
+	}
+	return targetObject
+}
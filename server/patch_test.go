@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatchEvent(t *testing.T) {
+	router := setupRouter()
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	slots := []Slot{{StartTime: start, EndTime: start.Add(2 * time.Hour)}}
+	events["patch"] = Event{
+		ID:            "patch",
+		Title:         "Test Event",
+		Slots:         slots,
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"p1", "p2"},
+		Organizer:     "organizer@example.com",
+		Status:        EventStatusOpen,
+	}
+	participants["p2"] = []Participant{{ID: "p2", EventID: "patch", Availability: slots}}
+
+	body := `{"title": "Renamed", "participants": ["p1", "p3"], "organizer": null}`
+	req, err := http.NewRequest("PATCH", "/event/patch", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	event := events["patch"]
+	assert.Equal(t, "Renamed", event.Title)
+	assert.Equal(t, slots[0].StartTime.Unix(), event.Slots[0].StartTime.Unix(), "Slots should be kept")
+	assert.Equal(t, 1*time.Hour, event.EstimatedTime)
+	assert.Equal(t, []string{"p1", "p3"}, event.Participants)
+	assert.Empty(t, event.Organizer, "null should clear the organizer")
+	assert.Equal(t, EventStatusOpen, event.Status)
+	assert.Empty(t, participants["p2"], "A removed participant's availability should be dropped")
+}
+
+func TestPatchEventRejected(t *testing.T) {
+	router := setupRouter()
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["patchbad"] = Event{
+		ID:            "patchbad",
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+	}
+
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		want        int
+	}{
+		{"read-only field", `{"status": "closed"}`, "application/merge-patch+json", http.StatusUnprocessableEntity},
+		{"invalid result", `{"title": null}`, "application/merge-patch+json", http.StatusUnprocessableEntity},
+		{"unknown field", `{"colour": "blue"}`, "application/merge-patch+json", http.StatusUnprocessableEntity},
+		{"not an object", `["title"]`, "application/merge-patch+json", http.StatusBadRequest},
+		{"wrong media type", `{"title": "x"}`, "text/plain", http.StatusUnsupportedMediaType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest("PATCH", "/event/patchbad", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("could not create request: %v", err)
+			}
+			req.Header.Set("Content-Type", tt.contentType)
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+			assert.Equal(t, "Test Event", events["patchbad"].Title, "A rejected patch should change nothing")
+		})
+	}
+}
+
+func TestUpdateEventReplacesParticipants(t *testing.T) {
+	router := setupRouter()
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	slots := []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}}
+	events["replace"] = Event{ID: "replace", Title: "Test Event", Slots: slots, EstimatedTime: time.Hour, Participants: []string{"r9"}}
+	participants["r9"] = []Participant{{ID: "r9", EventID: "replace", Availability: slots}}
+
+	updateJSON, _ := json.Marshal(Event{Title: "Replaced", Slots: slots, EstimatedTime: time.Hour})
+	req, err := http.NewRequest("PUT", "/event/replace", bytes.NewBuffer(updateJSON))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	assert.Equal(t, "Replaced", events["replace"].Title)
+	assert.Empty(t, events["replace"].Participants, "PUT should replace the participant list")
+	assert.Empty(t, participants["r9"])
+}
+
+func TestMergePatch(t *testing.T) {
+	var target, patch interface{}
+	json.Unmarshal([]byte(`{"a": "b", "c": {"d": "e", "f": "g"}}`), &target)
+	json.Unmarshal([]byte(`{"a": "z", "c": {"f": null}}`), &patch)
+	result, _ := json.Marshal(mergePatch(target, patch))
+	assert.JSONEq(t, `{"a": "z", "c": {"d": "e"}}`, string(result))
+}
+
+// A patch that is still being sent must not hold up other requests
+func TestPatchEventDoesNotBlockOtherRequests(t *testing.T) {
+	router := setupRouter()
+	events["patch-slow"] = Event{
+		ID:            "patch-slow",
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 13, 17, 0, 0, 0, time.UTC)}},
+		EstimatedTime: 1 * time.Hour,
+	}
+
+	body, upload := io.Pipe()
+	req := httptest.NewRequest("PATCH", "/v1/events/patch-slow", body)
+	req.Header.Set("Content-Type", mergePatchContentType)
+	rr := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		router.ServeHTTP(rr, req)
+	}()
+	io.WriteString(upload, `{"title":`)
+
+	read := make(chan error)
+	go func() {
+		_, err := schedule.GetEvent("patch-slow")
+		read <- err
+	}()
+	select {
+	case err := <-read:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the event to be readable while the patch is being sent")
+	}
+
+	io.WriteString(upload, `"Patched"}`)
+	upload.Close()
+	<-done
+	assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, "Patched", events["patch-slow"].Title)
+}
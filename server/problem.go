@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// Problem is an RFC 7807 problem details response. FieldError points at the
+// input that caused a problem; Row is set for problems with a row of an
+// uploaded file. Both are generated from openapi.yaml.
+type (
+	Problem    = api.Problem
+	FieldError = api.FieldError
+)
+
+// problemType is a kind of problem, one per error condition the API reports
+type problemType struct {
+	Type   string
+	Title  string
+	Status int
+}
+
+var (
+	problemInvalidInput        = problemType{"/problems/invalid-input", "Invalid input", http.StatusBadRequest}
+	problemValidation          = problemType{"/problems/validation", "Validation failed", http.StatusUnprocessableEntity}
+	problemEventNotFound       = problemType{"/problems/event-not-found", "Event not found", http.StatusNotFound}
+	problemParticipantNotFound = problemType{"/problems/participant-not-found", "Participant not found", http.StatusNotFound}
+	problemNotFound            = problemType{"/problems/not-found", "Not found", http.StatusNotFound}
+	problemMethodNotAllowed    = problemType{"/problems/method-not-allowed", "Method not allowed", http.StatusMethodNotAllowed}
+	problemUnsupportedMedia    = problemType{"/problems/unsupported-media-type", "Unsupported media type", http.StatusUnsupportedMediaType}
+	problemEventClosed         = problemType{"/problems/event-closed", "Event is closed for availability responses", http.StatusForbidden}
+	problemAlreadyRecorded     = problemType{"/problems/already-recorded", "This availability has already been recorded", http.StatusConflict}
+	problemSlotNotFound        = problemType{"/problems/slot-not-found", "Slot not found", http.StatusNotFound}
+	problemSlotFinalized       = problemType{"/problems/slot-finalized", "Slot is the event's finalized slot", http.StatusConflict}
+	problemInternal            = problemType{"/problems/internal", "Internal server error", http.StatusInternalServerError}
+)
+
+// problemError is a problem found by the scheduler. The HTTP handlers write it
+// as a problem details response and the gRPC server as a status.
+type problemError struct {
+	problem     problemType
+	detail      string
+	fieldErrors []FieldError
+}
+
+func (e *problemError) Error() string {
+	if e.detail != "" {
+		return e.problem.Title + ": " + e.detail
+	}
+	return e.problem.Title
+}
+
+// Report a problem from the scheduler
+func newProblem(problem problemType, detail string, fieldErrors ...FieldError) error {
+	return &problemError{problem: problem, detail: detail, fieldErrors: fieldErrors}
+}
+
+// Write an application/problem+json response
+func writeProblem(w http.ResponseWriter, problem problemType, detail string, fieldErrors ...FieldError) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(problem.Status)
+	json.NewEncoder(w).Encode(Problem{
+		Type:   problem.Type,
+		Title:  problem.Title,
+		Status: problem.Status,
+		Detail: detail,
+		Errors: fieldErrors,
+	})
+}
+
+// Write the problem details response for an error returned by the scheduler
+func writeError(w http.ResponseWriter, err error) {
+	var problem *problemError
+	if errors.As(err, &problem) {
+		writeProblem(w, problem.problem, problem.detail, problem.fieldErrors...)
+		return
+	}
+	writeProblem(w, problemInternal, "")
+}
+
+// The problem details of an error returned by the scheduler, for APIs that
+// send them in their own messages
+func problemDetails(err error) Problem {
+	problem := &problemError{problem: problemInternal}
+	errors.As(err, &problem)
+	return Problem{
+		Type:   problem.problem.Type,
+		Title:  problem.problem.Title,
+		Status: problem.problem.Status,
+		Detail: problem.detail,
+		Errors: problem.fieldErrors,
+	}
+}
+
+// Not Found Handler for unknown routes
+func notFound(w http.ResponseWriter, r *http.Request) {
+	writeProblem(w, problemNotFound, "No route for "+r.URL.Path)
+}
+
+// Method Not Allowed Handler for known routes
+func methodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeProblem(w, problemMethodNotAllowed, r.Method+" is not supported for "+r.URL.Path)
+}
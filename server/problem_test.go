@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProblemResponses(t *testing.T) {
+	router := setupRouter()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		want   problemType
+	}{
+		{"missing event", "GET", "/events/no-such-event", "", problemEventNotFound},
+		{"missing participant", "GET", "/participant/no-such-participant", "", problemParticipantNotFound},
+		{"invalid body", "POST", "/event", "{", problemInvalidInput},
+		{"unknown route", "GET", "/no-such-route", "", problemNotFound},
+		{"wrong method", "PATCH", "/event", "", problemMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("could not create request: %v", err)
+			}
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.want.Status, rr.Code)
+			assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+			var problem Problem
+			if err := json.NewDecoder(rr.Body).Decode(&problem); err != nil {
+				t.Fatalf("could not decode response: %v", err)
+			}
+			assert.Equal(t, tt.want.Type, problem.Type)
+			assert.Equal(t, tt.want.Title, problem.Title)
+			assert.Equal(t, tt.want.Status, problem.Status)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// The Redis channel every replica publishes its event updates to
+const redisUpdatesChannel = "event-scheduler:updates"
+
+// Each event's latest updates are kept in a Redis hash under this prefix, so
+// any replica can start a stream of an event another replica holds
+const redisStreamStatePrefix = "event-scheduler:stream:"
+
+// redisBroker shares event updates between replicas through Redis pub/sub.
+// Updates are published to Redis, and every replica, this one included,
+// delivers what it receives to its own streams. The latest event, availability
+// and recommendations updates of each event are stored alongside for
+// snapshots.
+type redisBroker struct {
+	*localBroker
+	client   *redis.Client
+	outgoing chan eventUpdate
+}
+
+// Start relaying updates through Redis. Redis being unavailable is not fatal:
+// the client keeps reconnecting and streams miss the updates meanwhile.
+func newRedisBroker(client *redis.Client) *redisBroker {
+	b := &redisBroker{
+		localBroker: newLocalBroker(),
+		client:      client,
+		outgoing:    make(chan eventUpdate, 1024),
+	}
+	// Subscribe before returning so no update published afterwards is missed
+	pubsub := client.Subscribe(context.Background(), redisUpdatesChannel)
+	if _, err := pubsub.Receive(context.Background()); err != nil {
+		log.Printf("Redis subscribe failed, retrying in the background: %v", err)
+	}
+	go b.receive(pubsub)
+	go b.send()
+	return b
+}
+
+// Publish queues the update for Redis so callers holding mu never wait on the
+// network. Updates are sent in the order they were published.
+func (b *redisBroker) Publish(update eventUpdate) {
+	select {
+	case b.outgoing <- update:
+	default:
+		log.Printf("Event %s: dropped %s update, Redis is not keeping up", update.EventID, update.Type)
+	}
+}
+
+// Send queued updates to Redis
+func (b *redisBroker) send() {
+	for update := range b.outgoing {
+		message, err := json.Marshal(update)
+		if err != nil {
+			continue
+		}
+		// Store the update and publish it together, so a snapshot taken after
+		// subscribing never misses an update that is not delivered
+		_, err = b.client.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+			key := redisStreamStatePrefix + update.EventID
+			if update.Type == streamDeleted {
+				pipe.Del(context.Background(), key)
+			} else if field := redisStateField(update); field != "" {
+				pipe.HSet(context.Background(), key, field, message)
+			}
+			pipe.Publish(context.Background(), redisUpdatesChannel, message)
+			return nil
+		})
+		if err != nil {
+			log.Printf("Event %s: could not publish %s update: %v", update.EventID, update.Type, err)
+		}
+	}
+}
+
+// The hash field holding the latest update of its kind: one for the event, one
+// for its recommendations and one per participant's availability
+func redisStateField(update eventUpdate) string {
+	switch update.Type {
+	case streamEventChanged, streamRecommendations:
+		return update.Type
+	case streamAvailability:
+		var availability availabilityMessage
+		if err := json.Unmarshal(update.Data, &availability); err != nil {
+			return ""
+		}
+		return streamAvailability + ":" + availability.ParticipantID
+	}
+	return ""
+}
+
+// Snapshot reads the event's latest updates from Redis, in the order a stream
+// starts with: the event, the availability of each of its participants, then
+// the recommendations
+func (b *redisBroker) Snapshot(eventID string) ([]eventUpdate, bool) {
+	state, err := b.client.HGetAll(context.Background(), redisStreamStatePrefix+eventID).Result()
+	if err != nil {
+		log.Printf("Event %s: could not read the stream state from Redis: %v", eventID, err)
+		return nil, false
+	}
+	decode := func(field string) (eventUpdate, bool) {
+		var update eventUpdate
+		message, found := state[field]
+		if !found {
+			return update, false
+		}
+		if err := json.Unmarshal([]byte(message), &update); err != nil {
+			log.Printf("Event %s: ignoring invalid %s state from Redis: %v", eventID, field, err)
+			return update, false
+		}
+		return update, true
+	}
+	eventChanged, found := decode(streamEventChanged)
+	if !found {
+		return nil, false
+	}
+	var event Event
+	if err := json.Unmarshal(eventChanged.Data, &event); err != nil {
+		log.Printf("Event %s: ignoring invalid event state from Redis: %v", eventID, err)
+		return nil, false
+	}
+	snapshot := []eventUpdate{eventChanged}
+	// Like eventParticipants: the event's participants in order, then anyone
+	// else who responded, by ID
+	for _, participantID := range event.Participants {
+		if availability, found := decode(streamAvailability + ":" + participantID); found {
+			snapshot = append(snapshot, availability)
+		} else {
+			snapshot = append(snapshot, newEventUpdate(eventID, streamAvailability, availabilityMessage{
+				ParticipantID: participantID,
+				Availability:  []Slot{},
+			}))
+		}
+	}
+	var others []string
+	for field := range state {
+		participantID, isAvailability := strings.CutPrefix(field, streamAvailability+":")
+		if isAvailability && !containsString(event.Participants, participantID) {
+			others = append(others, participantID)
+		}
+	}
+	sort.Strings(others)
+	for _, participantID := range others {
+		availability, found := decode(streamAvailability + ":" + participantID)
+		var message availabilityMessage
+		if found && json.Unmarshal(availability.Data, &message) == nil && message.Responded {
+			snapshot = append(snapshot, availability)
+		}
+	}
+	if recommendations, found := decode(streamRecommendations); found {
+		snapshot = append(snapshot, recommendations)
+	}
+	return snapshot, true
+}
+
+// Streams on any replica may read the event's updates, and the state kept for
+// their snapshots must stay current, so every event is watched
+func (b *redisBroker) Watched(eventID string) bool {
+	return true
+}
+
+// Deliver the updates every replica publishes to this replica's streams
+func (b *redisBroker) receive(pubsub *redis.PubSub) {
+	for message := range pubsub.Channel() {
+		var update eventUpdate
+		if err := json.Unmarshal([]byte(message.Payload), &update); err != nil {
+			log.Printf("Ignoring invalid update from Redis: %v", err)
+			continue
+		}
+		b.localBroker.Publish(update)
+	}
+}
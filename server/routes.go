@@ -0,0 +1,118 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+	"github.com/gorilla/mux"
+)
+
+// When the unversioned routes were deprecated in favour of /v1
+var routesDeprecatedAt = time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+// Register every route on the router. main and the tests share this so they
+// always serve the same API. The routes in openapi.yaml are registered by the
+// generated api package; the rest are deprecated aliases and WebDAV methods.
+// The aliases go through the same generated wrapper, so every handler gets
+// its parameters bound the same way.
+func registerRoutes(router *mux.Router) {
+	server := api.ServerInterfaceWrapper{Handler: apiServer{}, ErrorHandlerFunc: writeParameterError}
+
+	// gorilla/mux tries routes in order and {id} also matches "1.ics", so the
+	// calendar route has to come before the generated /v1/events/{id} route
+	router.HandleFunc("/v1/events/{id}.ics", server.GetEventCalendar).Methods("GET")
+	router.HandleFunc("/events/{id}.ics", deprecated(server.GetEventCalendar, "/v1/events/{id}.ics")).Methods("GET")
+
+	// Routes described by openapi.yaml
+	api.HandlerWithOptions(apiServer{}, api.GorillaServerOptions{
+		BaseRouter:       router,
+		ErrorHandlerFunc: writeParameterError,
+	})
+
+	// WebDAV methods OpenAPI cannot describe
+	router.HandleFunc("/caldav/{organizer}/", func(w http.ResponseWriter, r *http.Request) {
+		caldavCollection(w, r, mux.Vars(r)["organizer"])
+	}).Methods("PROPFIND", "REPORT")
+	router.HandleFunc("/caldav/{organizer}/{name}.ics", func(w http.ResponseWriter, r *http.Request) {
+		caldavObject(w, r, mux.Vars(r)["organizer"], mux.Vars(r)["name"])
+	}).Methods("PROPFIND")
+
+	// Deprecated Routes, kept as aliases of the /v1 routes
+	router.HandleFunc("/event", deprecated(server.CreateEvent, "/v1/events")).Methods("POST")
+	router.HandleFunc("/events/{id}", deprecated(server.GetEvent, "/v1/events/{id}")).Methods("GET")
+	router.HandleFunc("/event/{id}", deprecated(server.UpdateEvent, "/v1/events/{id}")).Methods("PUT")
+	router.HandleFunc("/event/{id}", deprecated(server.PatchEvent, "/v1/events/{id}")).Methods("PATCH")
+	router.HandleFunc("/event/{id}", deprecated(server.DeleteEvent, "/v1/events/{id}")).Methods("DELETE")
+	router.HandleFunc("/event/{id}/finalize", deprecated(server.FinalizeEvent, "/v1/events/{id}/finalize")).Methods("POST")
+	router.HandleFunc("/event/{id}/slots", deprecated(server.ListEventSlots, "/v1/events/{id}/slots")).Methods("GET")
+	router.HandleFunc("/event/{id}/slots", deprecated(server.AddEventSlot, "/v1/events/{id}/slots")).Methods("POST")
+	router.HandleFunc("/event/{id}/slots/{slot_id}", deprecated(server.DeleteEventSlot, "/v1/events/{id}/slots/{slot_id}")).Methods("DELETE")
+	router.HandleFunc("/event/{id}/find-common-slots", deprecated(server.FindCommonSlots, "/v1/events/{id}/find-common-slots")).Methods("GET")
+	router.HandleFunc("/event/{id}/freebusy", deprecated(server.GetEventFreeBusy, "/v1/events/{id}/freebusy")).Methods("GET")
+	router.HandleFunc("/event/{id}/export", deprecated(server.ExportEvent, "/v1/events/{id}/export")).Methods("GET")
+	router.HandleFunc("/event/{id}/heatmap", deprecated(server.GetEventHeatmap, "/v1/events/{id}/heatmap")).Methods("GET")
+	router.HandleFunc("/event/{id}/stream", deprecated(server.StreamEvent, "/v1/events/{id}/stream")).Methods("GET")
+	router.HandleFunc("/participant/{participant_id}", deprecated(server.GetParticipantAvailability, "/v1/participants/{participant_id}")).Methods("GET")
+	router.HandleFunc("/event/{id}/availability/import", deprecated(server.ImportAvailability, "/v1/events/{id}/participants/import")).Methods("POST")
+	router.HandleFunc("/event/{id}/participants/{participant_id}", deprecated(server.DeleteEventParticipant, "/v1/events/{id}/participants/{participant_id}")).Methods("DELETE")
+	router.HandleFunc("/event/{id}/participants/{participant_id}/slots", deprecated(server.ListParticipantSlots, "/v1/events/{id}/participants/{participant_id}/slots")).Methods("GET")
+	router.HandleFunc("/event/{id}/participants/{participant_id}/slots", deprecated(server.ReplaceParticipantSlots, "/v1/events/{id}/participants/{participant_id}/slots")).Methods("PUT")
+	router.HandleFunc("/event/{id}/participants/{participant_id}/slots", deprecated(server.AddParticipantSlot, "/v1/events/{id}/participants/{participant_id}/slots")).Methods("POST")
+	router.HandleFunc("/event/{id}/participants/{participant_id}/slots", deprecated(server.RemoveParticipantSlots, "/v1/events/{id}/participants/{participant_id}/slots")).Methods("DELETE")
+
+	// Unknown routes and methods get problem responses too
+	router.NotFoundHandler = http.HandlerFunc(notFound)
+	router.MethodNotAllowedHandler = http.HandlerFunc(methodNotAllowed)
+}
+
+// Wrap a handler served on a deprecated route, adding a Deprecation header
+// (RFC 9745) and a Link to the successor route with the request's path
+// variables filled in
+func deprecated(handler http.HandlerFunc, successor string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		markDeprecated(w, r, successor)
+		handler(w, r)
+	}
+}
+
+// Add the Deprecation header, and the Link to the successor route if there is
+// one, to the response of a deprecated route
+func markDeprecated(w http.ResponseWriter, r *http.Request, successor string) {
+	w.Header().Set("Deprecation", fmt.Sprintf("@%d", routesDeprecatedAt.Unix()))
+	if successor != "" {
+		link := successor
+		for name, value := range mux.Vars(r) {
+			link = strings.ReplaceAll(link, "{"+name+"}", value)
+		}
+		w.Header().Set("Link", "<"+link+">; rel=\"successor-version\"")
+	}
+}
+
+// Messages for query parameters the generated router could not parse
+var parameterMessages = map[string]string{
+	"start_time": "start_time must be an RFC 3339 time",
+	"end_time":   "end_time must be an RFC 3339 time",
+	"since":      "since must be a version number",
+}
+
+// Report a parameter the generated router could not bind as an invalid field
+func writeParameterError(w http.ResponseWriter, r *http.Request, err error) {
+	var invalid *api.InvalidParamFormatError
+	var required *api.RequiredParamError
+	switch {
+	case errors.As(err, &invalid):
+		message, ok := parameterMessages[invalid.ParamName]
+		if !ok {
+			message = invalid.ParamName + " is invalid"
+		}
+		writeProblem(w, problemInvalidInput, "", FieldError{Field: invalid.ParamName, Message: message})
+	case errors.As(err, &required):
+		writeProblem(w, problemInvalidInput, "", FieldError{Field: required.ParamName, Message: required.ParamName + " is required"})
+	default:
+		writeProblem(w, problemInvalidInput, err.Error())
+	}
+}
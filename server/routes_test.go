@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestV1Routes(t *testing.T) {
+	router := setupRouter()
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	eventJSON, _ := json.Marshal(Event{
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+	})
+	req, err := http.NewRequest("POST", "/v1/events", bytes.NewBuffer(eventJSON))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code, "Expected status code 201")
+	assert.Empty(t, rr.Header().Get("Deprecation"), "/v1 routes are not deprecated")
+
+	events["v1"] = Event{ID: "v1", Title: "Test Event", Slots: []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}}, EstimatedTime: time.Hour}
+	slotsJSON, _ := json.Marshal([]Slot{{StartTime: start, EndTime: start.Add(time.Hour)}})
+	req, _ = http.NewRequest("PUT", "/v1/events/v1/participants/u1/slots", bytes.NewBuffer(slotsJSON))
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusCreated, rr.Code, "Expected status code 201")
+
+	req, _ = http.NewRequest("GET", "/v1/participants/u1", nil)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+}
+
+func TestDeprecatedRoutes(t *testing.T) {
+	router := setupRouter()
+
+	events["old"] = Event{ID: "old", Title: "Test Event", EstimatedTime: time.Hour}
+	tests := []struct {
+		method string
+		path   string
+		link   string
+	}{
+		{"GET", "/events/old", `</v1/events/old>; rel="successor-version"`},
+		{"GET", "/event/old/heatmap", `</v1/events/old/heatmap>; rel="successor-version"`},
+		{"DELETE", "/participant/nobody/event/old", `</v1/events/old/participants/nobody>; rel="successor-version"`},
+		{"POST", "/participant", ""},
+	}
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, tt.path, strings.NewReader(""))
+		if err != nil {
+			t.Fatalf("could not create request: %v", err)
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		assert.Equal(t, "@1792281600", rr.Header().Get("Deprecation"), tt.path)
+		assert.Equal(t, tt.link, rr.Header().Get("Link"), tt.path)
+	}
+}
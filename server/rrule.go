@@ -0,0 +1,601 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRecurrences bounds how many occurrences a single rule can expand to
+const maxRecurrences = 5000
+
+// defaultRecurrenceHorizon is how far recurring slots are expanded past the
+// event's first slot when the event does not set a horizon
+const defaultRecurrenceHorizon = 90 * 24 * time.Hour
+
+// maxRecurrenceHorizon is the latest an event can set its horizon, measured
+// from its first slot
+const maxRecurrenceHorizon = 2 * 366 * 24 * time.Hour
+
+// rrule is the subset of an RFC 5545 recurrence rule the scheduler understands
+type rrule struct {
+	Freq       string
+	Interval   int
+	Count      int
+	Until      time.Time
+	ByDay      []rruleDay
+	ByMonthDay []int
+	ByMonth    []time.Month
+	BySetPos   []int
+}
+
+// rruleDay is a BYDAY entry such as MO, or 1MO and -1FR for the first Monday
+// and last Friday of the month or year
+type rruleDay struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+var icsWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// Parse a recurrence rule such as FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10
+func parseRRule(value string) (rrule, error) {
+	rule := rrule{Interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return rrule{}, fmt.Errorf("invalid RRULE part %q", part)
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Freq = strings.ToUpper(val)
+		case "INTERVAL":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return rrule{}, fmt.Errorf("invalid RRULE INTERVAL %q", val)
+			}
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return rrule{}, fmt.Errorf("invalid RRULE COUNT %q", val)
+			}
+			rule.Count = n
+		case "UNTIL":
+			until, _, err := parseICSTime(icsProperty{Value: val}, time.UTC)
+			if err != nil {
+				return rrule{}, fmt.Errorf("invalid RRULE UNTIL %q", val)
+			}
+			rule.Until = until
+		case "BYDAY":
+			for _, day := range strings.Split(val, ",") {
+				day = strings.ToUpper(day)
+				if len(day) < 2 {
+					return rrule{}, fmt.Errorf("invalid RRULE BYDAY %q", day)
+				}
+				weekday, ok := icsWeekdays[day[len(day)-2:]]
+				if !ok {
+					return rrule{}, fmt.Errorf("invalid RRULE BYDAY %q", day)
+				}
+				ordinal := 0
+				if prefix := day[:len(day)-2]; prefix != "" {
+					n, err := strconv.Atoi(prefix)
+					if err != nil || n == 0 || n < -53 || n > 53 {
+						return rrule{}, fmt.Errorf("invalid RRULE BYDAY %q", day)
+					}
+					ordinal = n
+				}
+				rule.ByDay = append(rule.ByDay, rruleDay{Ordinal: ordinal, Weekday: weekday})
+			}
+		case "BYMONTHDAY":
+			days, err := parseRRuleNumbers(val, 31)
+			if err != nil {
+				return rrule{}, fmt.Errorf("invalid RRULE BYMONTHDAY %q", val)
+			}
+			rule.ByMonthDay = days
+		case "BYMONTH":
+			months, err := parseRRuleNumbers(val, 12)
+			if err != nil {
+				return rrule{}, fmt.Errorf("invalid RRULE BYMONTH %q", val)
+			}
+			for _, month := range months {
+				if month < 0 {
+					return rrule{}, fmt.Errorf("invalid RRULE BYMONTH %q", val)
+				}
+				rule.ByMonth = append(rule.ByMonth, time.Month(month))
+			}
+		case "BYSETPOS":
+			positions, err := parseRRuleNumbers(val, 366)
+			if err != nil {
+				return rrule{}, fmt.Errorf("invalid RRULE BYSETPOS %q", val)
+			}
+			rule.BySetPos = positions
+		case "WKST":
+			// Weeks always start on Monday here
+		default:
+			return rrule{}, fmt.Errorf("unsupported RRULE part %q", key)
+		}
+	}
+	switch rule.Freq {
+	case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+	default:
+		return rrule{}, fmt.Errorf("unsupported RRULE FREQ %q", rule.Freq)
+	}
+	// Ordinal weekdays and set positions pick days within a month or year
+	if rule.Freq == "DAILY" || rule.Freq == "WEEKLY" {
+		for _, day := range rule.ByDay {
+			if day.Ordinal != 0 {
+				return rrule{}, fmt.Errorf("RRULE BYDAY ordinals are only supported with FREQ=MONTHLY or FREQ=YEARLY")
+			}
+		}
+		if len(rule.BySetPos) > 0 {
+			return rrule{}, fmt.Errorf("RRULE BYSETPOS is only supported with FREQ=MONTHLY or FREQ=YEARLY")
+		}
+	}
+	if rule.Freq == "WEEKLY" && len(rule.ByMonthDay) > 0 {
+		return rrule{}, fmt.Errorf("RRULE BYMONTHDAY is not allowed with FREQ=WEEKLY")
+	}
+	return rule, nil
+}
+
+// Parse a comma-separated list of numbers between 1 and max, or -max and -1
+// counting from the end
+func parseRRuleNumbers(value string, max int) ([]int, error) {
+	var numbers []int
+	for _, part := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(part)
+		if err != nil || n == 0 || n < -max || n > max {
+			return nil, fmt.Errorf("invalid number %q", part)
+		}
+		numbers = append(numbers, n)
+	}
+	return numbers, nil
+}
+
+// Expand the rule from dtstart, returning every occurrence start from from up
+// to end. COUNT is honoured from dtstart, so occurrences before from still
+// count; whole periods before from are skipped without being walked, so a
+// long-running rule costs the same as a new one. Reports false if the
+// occurrences in the window could not all be found within maxRecurrences.
+func (rule rrule) expand(dtstart time.Time, from time.Time, end time.Time) ([]time.Time, bool) {
+	var occurrences []time.Time
+	first, counted := rule.skipPeriods(dtstart, from)
+	for period := first; period < first+maxRecurrences; period++ {
+		starts, periodStart := rule.periodStarts(dtstart, period)
+		if !periodStart.Before(end) {
+			return occurrences, true
+		}
+		for _, t := range starts {
+			if t.Before(dtstart) {
+				continue
+			}
+			if !t.Before(end) || (!rule.Until.IsZero() && t.After(rule.Until)) {
+				return occurrences, true
+			}
+			if rule.Count > 0 && counted >= rule.Count {
+				return occurrences, true
+			}
+			counted++
+			if t.Before(from) {
+				continue
+			}
+			occurrences = append(occurrences, t)
+			if len(occurrences) >= maxRecurrences {
+				return occurrences, false
+			}
+		}
+	}
+	return occurrences, false
+}
+
+// Work out how many whole periods from dtstart end before from, and how many
+// occurrences they hold for COUNT. Periods are only skipped when both can be
+// worked out arithmetically; otherwise expansion walks from dtstart.
+func (rule rrule) skipPeriods(dtstart time.Time, from time.Time) (int, int) {
+	days := daysBetween(dtstart, from)
+	if days <= 7 {
+		return 0, 0
+	}
+	switch rule.Freq {
+	case "DAILY":
+		periods := (days - 1) / rule.Interval
+		if rule.Count == 0 {
+			return periods, 0
+		}
+		if len(rule.ByMonth) > 0 || len(rule.ByMonthDay) > 0 {
+			return 0, 0
+		}
+		if len(rule.ByDay) == 0 {
+			return periods, periods
+		}
+		// Weekdays repeat every seven periods
+		perCycle := 0
+		for i := 0; i < 7; i++ {
+			if rule.onDays(dtstart.AddDate(0, 0, i*rule.Interval).Weekday()) {
+				perCycle++
+			}
+		}
+		cycles := periods / 7
+		return cycles * 7, cycles * perCycle
+	case "WEEKLY":
+		periods := (daysBetween(dtstart, from) + mondayOffset(dtstart.Weekday()) - 7) / (7 * rule.Interval)
+		if rule.Count == 0 || periods < 1 {
+			return periods, 0
+		}
+		if len(rule.ByMonth) > 0 {
+			return 0, 0
+		}
+		// The first week only counts the days from dtstart on
+		firstWeek, perWeek := 0, 0
+		for offset := 0; offset < 7; offset++ {
+			if rule.weeklyDay(dtstart, time.Weekday((offset+1)%7)) {
+				perWeek++
+				if offset >= mondayOffset(dtstart.Weekday()) {
+					firstWeek++
+				}
+			}
+		}
+		return periods, firstWeek + (periods-1)*perWeek
+	default:
+		if rule.Count > 0 {
+			return 0, 0
+		}
+		from = from.In(dtstart.Location())
+		months := (from.Year()-dtstart.Year())*12 + int(from.Month()-dtstart.Month())
+		if rule.Freq == "YEARLY" {
+			months /= 12
+		}
+		if periods := months/rule.Interval - 1; periods > 0 {
+			return periods, 0
+		}
+		return 0, 0
+	}
+}
+
+// Get the candidate occurrence starts of one period of the rule in order,
+// along with the start of the period. Candidates before dtstart are left for
+// the caller to drop.
+func (rule rrule) periodStarts(dtstart time.Time, period int) ([]time.Time, time.Time) {
+	switch rule.Freq {
+	case "DAILY":
+		t := dtstart.AddDate(0, 0, period*rule.Interval)
+		if len(rule.ByDay) > 0 && !rule.onDays(t.Weekday()) {
+			return nil, t
+		}
+		if !rule.inMonths(t.Month()) || !rule.onMonthDays(t) {
+			return nil, t
+		}
+		return []time.Time{t}, t
+	case "WEEKLY":
+		// Walk the days of this week in order, starting from Monday
+		weekStart := dtstart.AddDate(0, 0, -mondayOffset(dtstart.Weekday())+period*7*rule.Interval)
+		var starts []time.Time
+		for offset := 0; offset < 7; offset++ {
+			t := weekStart.AddDate(0, 0, offset)
+			if rule.weeklyDay(dtstart, t.Weekday()) && rule.inMonths(t.Month()) {
+				starts = append(starts, t)
+			}
+		}
+		return starts, weekStart
+	case "MONTHLY":
+		monthStart := dayAt(dtstart, dtstart.Year(), dtstart.Month()+time.Month(period*rule.Interval), 1)
+		if !rule.inMonths(monthStart.Month()) {
+			return nil, monthStart
+		}
+		return rule.setPositions(rule.monthDays(dtstart, monthStart.Year(), monthStart.Month())), monthStart
+	default:
+		year := dtstart.Year() + period*rule.Interval
+		yearStart := dayAt(dtstart, year, time.January, 1)
+		var starts []time.Time
+		if len(rule.ByDay) > 0 && len(rule.ByMonth) == 0 && len(rule.ByMonthDay) == 0 {
+			// Weekdays, and their ordinals, are counted across the whole year
+			yearLength := dayAt(dtstart, year, time.December, 31).YearDay()
+			for t := yearStart; t.Year() == year; t = t.AddDate(0, 0, 1) {
+				if rule.matchesDay(t.Weekday(), (t.YearDay()-1)/7+1, (yearLength-t.YearDay())/7+1) {
+					starts = append(starts, t)
+				}
+			}
+			return rule.setPositions(starts), yearStart
+		}
+		months := rule.ByMonth
+		if len(months) == 0 {
+			months = []time.Month{dtstart.Month()}
+			if len(rule.ByMonthDay) > 0 {
+				months = []time.Month{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+			}
+		}
+		for month := time.January; month <= time.December; month++ {
+			if containsMonth(months, month) {
+				starts = append(starts, rule.monthDays(dtstart, year, month)...)
+			}
+		}
+		return rule.setPositions(starts), yearStart
+	}
+}
+
+// Get the days of a month the rule picks, in order, at dtstart's time of day.
+// Without BYMONTHDAY or BYDAY that is the day of dtstart, if the month has it.
+func (rule rrule) monthDays(dtstart time.Time, year int, month time.Month) []time.Time {
+	last := dayAt(dtstart, year, month+1, 0).Day()
+	var days []time.Time
+	for day := 1; day <= last; day++ {
+		t := dayAt(dtstart, year, month, day)
+		switch {
+		case len(rule.ByMonthDay) > 0:
+			if !rule.onMonthDays(t) {
+				continue
+			}
+			if len(rule.ByDay) > 0 && !rule.matchesDay(t.Weekday(), (day-1)/7+1, (last-day)/7+1) {
+				continue
+			}
+		case len(rule.ByDay) > 0:
+			if !rule.matchesDay(t.Weekday(), (day-1)/7+1, (last-day)/7+1) {
+				continue
+			}
+		default:
+			if day != dtstart.Day() {
+				continue
+			}
+		}
+		days = append(days, t)
+	}
+	return days
+}
+
+// Keep the BYSETPOS positions of a period's candidates, counting from the end
+// for negative positions
+func (rule rrule) setPositions(starts []time.Time) []time.Time {
+	if len(rule.BySetPos) == 0 {
+		return starts
+	}
+	var kept []time.Time
+	for i, t := range starts {
+		for _, position := range rule.BySetPos {
+			if position == i+1 || position == i-len(starts) {
+				kept = append(kept, t)
+				break
+			}
+		}
+	}
+	return kept
+}
+
+// Check a day matches a BYDAY entry, given which occurrence of its weekday it
+// is counting from the start and from the end of the month or year
+func (rule rrule) matchesDay(weekday time.Weekday, nth int, nthFromEnd int) bool {
+	for _, day := range rule.ByDay {
+		if day.Weekday != weekday {
+			continue
+		}
+		if day.Ordinal == 0 || day.Ordinal == nth || day.Ordinal == -nthFromEnd {
+			return true
+		}
+	}
+	return false
+}
+
+// Check a weekday is one of the rule's BYDAY weekdays
+func (rule rrule) onDays(weekday time.Weekday) bool {
+	return rule.matchesDay(weekday, 0, 0)
+}
+
+// Check a weekly rule repeats on the weekday: its BYDAY weekdays, or the
+// weekday of dtstart
+func (rule rrule) weeklyDay(dtstart time.Time, weekday time.Weekday) bool {
+	if len(rule.ByDay) == 0 {
+		return weekday == dtstart.Weekday()
+	}
+	return rule.onDays(weekday)
+}
+
+// Check a month is one of the rule's BYMONTH months, if it has any
+func (rule rrule) inMonths(month time.Month) bool {
+	return len(rule.ByMonth) == 0 || containsMonth(rule.ByMonth, month)
+}
+
+// Check a day is one of the rule's BYMONTHDAY days, if it has any
+func (rule rrule) onMonthDays(t time.Time) bool {
+	if len(rule.ByMonthDay) == 0 {
+		return true
+	}
+	last := dayAt(t, t.Year(), t.Month()+1, 0).Day()
+	for _, day := range rule.ByMonthDay {
+		if day == t.Day() || day == t.Day()-last-1 {
+			return true
+		}
+	}
+	return false
+}
+
+// Get a day at the time of day and in the location of dtstart
+func dayAt(dtstart time.Time, year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, dtstart.Hour(), dtstart.Minute(), dtstart.Second(), dtstart.Nanosecond(), dtstart.Location())
+}
+
+// Count the calendar days from a's date to b's date in a's location
+func daysBetween(a time.Time, b time.Time) int {
+	b = b.In(a.Location())
+	from := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func containsMonth(months []time.Month, month time.Month) bool {
+	for _, m := range months {
+		if m == month {
+			return true
+		}
+	}
+	return false
+}
+
+// Expand recurring slots into their occurrences that start before horizon.
+// Slots without a rule are returned as they are.
+func expandSlots(slots []Slot, horizon time.Time) []Slot {
+	var expanded []Slot
+	for _, slot := range slots {
+		if slot.RRule == "" {
+			expanded = append(expanded, slot)
+			continue
+		}
+		rule, err := parseRRule(slot.RRule)
+		if err != nil {
+			// Rules are validated on write, so treat a bad one as a single slot
+			expanded = append(expanded, Slot{ID: slot.ID, StartTime: slot.StartTime, EndTime: slot.EndTime})
+			continue
+		}
+		length := slot.EndTime.Sub(slot.StartTime)
+		starts, _ := rule.expand(recurrenceStart(slot), time.Time{}, horizon)
+		for _, start := range starts {
+			if containsTime(slot.ExDates, start) {
+				continue
+			}
+			expanded = append(expanded, Slot{ID: slot.ID, StartTime: start, EndTime: start.Add(length)})
+		}
+	}
+	return expanded
+}
+
+// Get the start of a recurring slot in its zone, so the rule keeps the wall
+// clock time there across DST changes. Without a zone the rule is expanded in
+// the fixed offset of the start time.
+func recurrenceStart(slot Slot) time.Time {
+	if slot.Zone == "" {
+		return slot.StartTime
+	}
+	loc, err := time.LoadLocation(slot.Zone)
+	if err != nil {
+		// Zones are validated on write
+		return slot.StartTime
+	}
+	return slot.StartTime.In(loc)
+}
+
+// Get the time up to which the event's recurring slots are expanded
+func eventHorizon(event Event) time.Time {
+	if event.Horizon != nil {
+		return *event.Horizon
+	}
+	var earliest, latest time.Time
+	recurring := false
+	for i, slot := range event.Slots {
+		if i == 0 || slot.StartTime.Before(earliest) {
+			earliest = slot.StartTime
+		}
+		if slot.EndTime.After(latest) {
+			latest = slot.EndTime
+		}
+		if slot.RRule != "" {
+			recurring = true
+		}
+	}
+	if recurring && earliest.Add(defaultRecurrenceHorizon).After(latest) {
+		latest = earliest.Add(defaultRecurrenceHorizon)
+	}
+	return latest
+}
+
+// Get the time up to which the recurring availability of the event's
+// participants is expanded: the event's horizon, or the end of its series if
+// that is later
+func availabilityHorizon(event Event) time.Time {
+	horizon := eventHorizon(event)
+	if event.Series == nil {
+		return horizon
+	}
+	for _, candidate := range eventSlots(event) {
+		occurrences := seriesOccurrences(candidate, *event.Series)
+		if last := occurrences[len(occurrences)-1]; last.EndTime.After(horizon) {
+			horizon = last.EndTime
+		}
+	}
+	return horizon
+}
+
+// Get the event's candidate slots with recurring slots expanded
+func eventSlots(event Event) []Slot {
+	return expandSlots(event.Slots, eventHorizon(event))
+}
+
+// Check every recurring slot has a rule the scheduler understands
+func validateRecurrence(slots []Slot) error {
+	for _, slot := range slots {
+		if slot.RRule == "" {
+			if len(slot.ExDates) > 0 {
+				return fmt.Errorf("exdates require an rrule")
+			}
+			continue
+		}
+		if _, err := parseRRule(slot.RRule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Number of days since the most recent Monday
+func mondayOffset(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
+
+// Parse an RFC 5545 duration such as PT1H30M, P1D or -PT15M
+func parseICSDuration(value string) (time.Duration, error) {
+	s := value
+	sign := time.Duration(1)
+	if strings.HasPrefix(s, "-") {
+		sign = -1
+		s = s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	s = s[1:]
+	var total time.Duration
+	inTime := false
+	for s != "" {
+		if s[0] == 'T' {
+			inTime = true
+			s = s[1:]
+			continue
+		}
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		n, _ := strconv.Atoi(s[:i])
+		unit := time.Duration(n)
+		switch {
+		case s[i] == 'W' && !inTime:
+			total += unit * 7 * 24 * time.Hour
+		case s[i] == 'D' && !inTime:
+			total += unit * 24 * time.Hour
+		case s[i] == 'H' && inTime:
+			total += unit * time.Hour
+		case s[i] == 'M' && inTime:
+			total += unit * time.Minute
+		case s[i] == 'S' && inTime:
+			total += unit * time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		s = s[i+1:]
+	}
+	return sign * total, nil
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindCommonSlotsRecurring(t *testing.T) {
+	router := setupRouter()
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+
+	// The organizer offers 2-3PM UTC every weekday of one week
+	monday := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["weekly"] = Event{
+		ID:    "weekly",
+		Title: "Test Event",
+		Slots: []Slot{{
+			StartTime: monday,
+			EndTime:   monday.Add(time.Hour),
+			RRule:     "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;COUNT=5",
+		}},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"rec1"},
+	}
+	// The participant is free every weekday 9-11 ET except Wednesday
+	nineET := time.Date(2025, 1, 13, 9, 0, 0, 0, newYork)
+	participants["rec1"] = []Participant{{ID: "rec1", EventID: "weekly", Availability: []Slot{{
+		StartTime: nineET,
+		EndTime:   nineET.Add(2 * time.Hour),
+		RRule:     "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+		ExDates:   []time.Time{nineET.AddDate(0, 0, 2)},
+	}}}}
+
+	req, err := http.NewRequest("GET", "/event/weekly/find-common-slots", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	var response AvailabilityResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+
+	var starts []time.Time
+	for _, recommended := range response.RecommendedTimeSlots {
+		assert.Empty(t, recommended.UnavailableParticipants)
+		starts = append(starts, recommended.Slot.StartTime.UTC())
+	}
+	assert.Equal(t, []time.Time{monday, monday.AddDate(0, 0, 1), monday.AddDate(0, 0, 3), monday.AddDate(0, 0, 4)}, starts)
+}
+
+func TestCreateEventInvalidRecurrence(t *testing.T) {
+	router := setupRouter()
+
+	event := Event{
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: time.Now(), EndTime: time.Now().Add(1 * time.Hour), RRule: "FREQ=HOURLY"}},
+		EstimatedTime: 1 * time.Hour,
+	}
+	eventJSON, _ := json.Marshal(event)
+	req, err := http.NewRequest("POST", "/event", bytes.NewBuffer(eventJSON))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, "Expected status code 422")
+}
+
+func TestRRuleExpandWeekly(t *testing.T) {
+	rule, err := parseRRule("FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=3")
+	if err != nil {
+		t.Fatalf("could not parse rule: %v", err)
+	}
+	dtstart := time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC) // a Monday
+	occurrences, complete := rule.expand(dtstart, dtstart, dtstart.AddDate(1, 0, 0))
+	assert.True(t, complete)
+
+	assert.Equal(t, []time.Time{
+		dtstart,
+		dtstart.AddDate(0, 0, 2),
+		dtstart.AddDate(0, 0, 14),
+	}, occurrences)
+}
+
+func TestRecurringAvailabilityKeepsWallClockAcrossDST(t *testing.T) {
+	router := setupRouter()
+
+	// The organizer offers 1-2PM UTC on the Monday after US clocks go forward,
+	// which is 9-10AM in New York
+	candidate := time.Date(2025, 3, 17, 13, 0, 0, 0, time.UTC)
+	events["dst"] = Event{
+		ID:            "dst",
+		Title:         "Test Event",
+		Slots:         []Slot{{ID: "1", StartTime: candidate, EndTime: candidate.Add(time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"dst1", "dst2"},
+		lastSlotID:    1,
+	}
+	for _, participantID := range []string{"dst1", "dst2"} {
+		delete(participants, participantID)
+	}
+
+	// Both are free 9-10AM New York time every Monday from before the change,
+	// but only dst1 says which zone that is in
+	submit := func(participantID string, body string) {
+		req, err := http.NewRequest("PUT", "/event/dst/participants/"+participantID+"/slots", bytes.NewBufferString(body))
+		if err != nil {
+			t.Fatalf("could not create request: %v", err)
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusCreated, rr.Code, "Expected status code 201: %s", rr.Body.String())
+	}
+	submit("dst1", `[{"start_time":"2025-03-03T09:00:00-05:00","end_time":"2025-03-03T10:00:00-05:00","rrule":"FREQ=WEEKLY","zone":"America/New_York"}]`)
+	submit("dst2", `[{"start_time":"2025-03-03T09:00:00-05:00","end_time":"2025-03-03T10:00:00-05:00","rrule":"FREQ=WEEKLY"}]`)
+
+	req, err := http.NewRequest("GET", "/event/dst/find-common-slots", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	var response AvailabilityResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	// Without a zone the rule keeps the -05:00 offset and lands an hour late
+	if assert.Len(t, response.RecommendedTimeSlots, 1) {
+		assert.Equal(t, []string{"dst2"}, response.RecommendedTimeSlots[0].UnavailableParticipants)
+	}
+}
+
+func TestParseRRuleByDayOrdinals(t *testing.T) {
+	for _, value := range []string{"FREQ=MONTHLY;BYDAY=MO", "FREQ=YEARLY;BYDAY=-1FR", "FREQ=DAILY;BYDAY=MO,TU"} {
+		_, err := parseRRule(value)
+		assert.NoError(t, err, value)
+	}
+	// Ordinals and set positions only make sense within a month or year
+	for _, value := range []string{"FREQ=WEEKLY;BYDAY=1MO", "FREQ=DAILY;BYSETPOS=1", "FREQ=WEEKLY;BYMONTHDAY=1", "FREQ=MONTHLY;BYDAY=0MO"} {
+		_, err := parseRRule(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestRRuleExpandMonthlyAndYearly(t *testing.T) {
+	dtstart := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC) // the first Monday of 2025
+	expand := func(value string, count int) []string {
+		rule, err := parseRRule(value)
+		if err != nil {
+			t.Fatalf("could not parse rule %s: %v", value, err)
+		}
+		occurrences, complete := rule.expand(dtstart, dtstart, dtstart.AddDate(3, 0, 0))
+		assert.True(t, complete, value)
+		var dates []string
+		for _, occurrence := range occurrences {
+			dates = append(dates, occurrence.Format("2006-01-02"))
+		}
+		if len(dates) > count {
+			dates = dates[:count]
+		}
+		return dates
+	}
+
+	assert.Equal(t, []string{"2025-01-06", "2025-02-03", "2025-03-03"}, expand("FREQ=MONTHLY;BYDAY=1MO", 3))
+	assert.Equal(t, []string{"2025-01-31", "2025-02-28", "2025-03-31"}, expand("FREQ=MONTHLY;BYMONTHDAY=-1", 3))
+	assert.Equal(t, []string{"2025-01-31", "2025-02-28", "2025-03-31", "2025-04-30", "2025-05-30"}, expand("FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", 5))
+	assert.Equal(t, []string{"2025-01-13", "2025-04-14"}, expand("FREQ=MONTHLY;INTERVAL=3;BYDAY=2MO", 2))
+	assert.Equal(t, []string{"2025-11-27", "2026-11-26", "2027-11-25"}, expand("FREQ=YEARLY;BYMONTH=11;BYDAY=4TH", 3))
+	assert.Equal(t, []string{"2025-12-29", "2026-12-28"}, expand("FREQ=YEARLY;BYDAY=-1MO", 2))
+	assert.Equal(t, []string{"2025-01-13", "2025-01-20"}, expand("FREQ=MONTHLY;BYDAY=MO;BYMONTHDAY=13,14,15,16,17,18,19,20,21;COUNT=2", 5))
+}
+
+func TestRRuleExpandSkipsToTheWindow(t *testing.T) {
+	// A rule that started years ago is expanded from the window, and COUNT
+	// still counts the occurrences before it
+	dtstart := time.Date(2010, 1, 4, 9, 0, 0, 0, time.UTC) // a Monday
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	end := from.AddDate(0, 0, 28)
+	for _, value := range []string{
+		"FREQ=DAILY",
+		"FREQ=DAILY;INTERVAL=3",
+		"FREQ=DAILY;BYDAY=MO,WE,FR",
+		"FREQ=DAILY;INTERVAL=2;BYDAY=TU,TH;COUNT=1250",
+		"FREQ=DAILY;COUNT=5848",
+		"FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=SU,WE;COUNT=837",
+		"FREQ=MONTHLY;BYDAY=1MO,-1FR",
+		"FREQ=YEARLY;BYMONTH=1;BYDAY=MO",
+	} {
+		rule, err := parseRRule(value)
+		if err != nil {
+			t.Fatalf("could not parse rule %s: %v", value, err)
+		}
+		occurrences, complete := rule.expand(dtstart, from, end)
+		assert.True(t, complete, value)
+
+		// Walking every period from dtstart gives the same occurrences
+		var walked []time.Time
+		counted := 0
+		for period := 0; ; period++ {
+			starts, periodStart := rule.periodStarts(dtstart, period)
+			if !periodStart.Before(end) {
+				break
+			}
+			for _, start := range starts {
+				if start.Before(dtstart) || !start.Before(end) || (rule.Count > 0 && counted >= rule.Count) {
+					continue
+				}
+				counted++
+				if !start.Before(from) {
+					walked = append(walked, start)
+				}
+			}
+		}
+		assert.NotEmpty(t, walked, value)
+		assert.Equal(t, walked, occurrences, value)
+	}
+}
@@ -0,0 +1,554 @@
+package main
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+// scheduler holds the scheduling logic shared by the HTTP handlers, the gRPC
+// server and the GraphQL resolvers. Its methods take mu themselves and report failures as problem
+// errors, which each API turns into its own kind of error response.
+type scheduler struct{}
+
+// schedule is the scheduler every API uses
+var schedule scheduler
+
+// lastEventID is the highest event ID handed out, guarded by mu
+var lastEventID int
+
+// availabilityUpdate is a participant's availability as stored, with how the
+// submitted slots were changed to store them
+type availabilityUpdate struct {
+	Availability []Slot
+	Normalized   AvailabilityNormalization
+	// Created is set when this was the participant's first response to the event
+	Created bool
+}
+
+// availabilityImport counts what a bulk import stored
+type availabilityImport struct {
+	Participants int
+	Slots        int
+	Normalized   AvailabilityNormalization
+}
+
+// eventParticipant is a participant's availability for an event, which is
+// empty if they have not responded to it
+type eventParticipant struct {
+	Participant
+	Responded bool
+}
+
+// Create an event, giving it and its slots IDs
+func (scheduler) CreateEvent(event Event) (Event, error) {
+	if fieldErrors := validateEvent(event); len(fieldErrors) > 0 {
+		return Event{}, newProblem(problemValidation, "", fieldErrors...)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	// Generate a unique ID for the event. IDs are never reused, even after
+	// the event is deleted.
+	for {
+		lastEventID++
+		event.ID = strconv.Itoa(lastEventID)
+		if _, taken := events[event.ID]; !taken {
+			break
+		}
+	}
+	assignSlotIDs(&event, nil)
+	event.Status = EventStatusOpen
+	event.FinalizedSlot = nil
+	event.AtRisk = false
+	event.Conflicts = nil
+	events[event.ID] = event
+	return event, nil
+}
+
+// Get an event
+func (scheduler) GetEvent(eventID string) (Event, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, exists := events[eventID]
+	if !exists {
+		return Event{}, newProblem(problemEventNotFound, "")
+	}
+	return event, nil
+}
+
+// List every event, ordered by ID
+func (scheduler) ListEvents() []Event {
+	mu.Lock()
+	defer mu.Unlock()
+
+	list := make([]Event, 0, len(events))
+	for _, event := range events {
+		list = append(list, event)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return eventIDLess(list[i].ID, list[j].ID)
+	})
+	return list
+}
+
+// Order event IDs numerically, as they are handed out, with any IDs that are
+// not numbers after them in string order
+func eventIDLess(a string, b string) bool {
+	x, errA := strconv.Atoi(a)
+	y, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		return x < y
+	case errA == nil || errB == nil:
+		return errA == nil
+	default:
+		return a < b
+	}
+}
+
+// Replace every client-writable field of an event
+func (scheduler) UpdateEvent(eventID string, updatedEvent Event) (Event, error) {
+	if fieldErrors := validateEvent(updatedEvent); len(fieldErrors) > 0 {
+		return Event{}, newProblem(problemValidation, "", fieldErrors...)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, exists := events[eventID]; !exists {
+		return Event{}, newProblem(problemEventNotFound, "")
+	}
+	replaceEvent(eventID, updatedEvent)
+	return events[eventID], nil
+}
+
+// Apply a JSON merge patch to the event's JSON form: members set to null are
+// cleared, objects are merged and everything else, including arrays such as
+// participants, is replaced. The fields the server manages cannot be patched.
+func (scheduler) PatchEvent(eventID string, patch map[string]interface{}) (Event, error) {
+	var fieldErrors []FieldError
+	for _, field := range readOnlyEventFields {
+		if _, ok := patch[field]; ok {
+			fieldErrors = append(fieldErrors, FieldError{Field: field, Message: "field is read-only"})
+		}
+	}
+	if len(fieldErrors) > 0 {
+		return Event{}, newProblem(problemValidation, "", fieldErrors...)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, exists := events[eventID]
+	if !exists {
+		return Event{}, newProblem(problemEventNotFound, "")
+	}
+	patchedEvent, fieldErrors, err := applyEventPatch(event, patch)
+	if err != nil {
+		return Event{}, newProblem(problemInvalidInput, err.Error())
+	}
+	// Validate the event, reporting every invalid field
+	if fieldErrors = append(fieldErrors, validateEvent(patchedEvent)...); len(fieldErrors) > 0 {
+		return Event{}, newProblem(problemValidation, "", fieldErrors...)
+	}
+	replaceEvent(eventID, patchedEvent)
+	return events[eventID], nil
+}
+
+// Delete an event
+func (scheduler) DeleteEvent(eventID string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, exists := events[eventID]; !exists {
+		return newProblem(problemEventNotFound, "")
+	}
+	delete(events, eventID)
+	publishDeleted(eventID)
+	return nil
+}
+
+// Set the meeting time of an event to one of its slots, given by its times or
+// just its ID
+func (scheduler) FinalizeEvent(eventID string, slot Slot) (Event, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, exists := events[eventID]
+	if !exists {
+		return Event{}, newProblem(problemEventNotFound, "")
+	}
+	// The finalized slot must be one of the event's candidate slots
+	slot, found := findSlot(eventSlots(event), slot)
+	if !found {
+		return Event{}, newProblem(problemInvalidInput, "Slot is not one of the event's slots")
+	}
+	event.FinalizedSlot = &slot
+	events[eventID] = event
+	revalidateFinalizedSlot(eventID, nil)
+	publishEvent(eventID)
+	return events[eventID], nil
+}
+
+// List an event's candidate slots
+func (scheduler) ListEventSlots(eventID string) ([]Slot, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, exists := events[eventID]
+	if !exists {
+		return nil, newProblem(problemEventNotFound, "")
+	}
+	if event.Slots == nil {
+		return []Slot{}, nil
+	}
+	return event.Slots, nil
+}
+
+// Add a candidate slot to an event, giving it a new ID
+func (scheduler) AddEventSlot(eventID string, slot Slot) (Slot, error) {
+	if fieldErrors := validateSlot("", slot); len(fieldErrors) > 0 {
+		return Slot{}, newProblem(problemValidation, "", fieldErrors...)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, exists := events[eventID]
+	if !exists {
+		return Slot{}, newProblem(problemEventNotFound, "")
+	}
+	// The server assigns the slot ID
+	slot.ID = ""
+	previousSlots := event.Slots
+	event.Slots = append(append([]Slot(nil), previousSlots...), slot)
+	assignSlotIDs(&event, previousSlots)
+	events[eventID] = event
+	tagEventAvailability(eventID)
+	revalidateFinalizedSlot(eventID, nil)
+	publishEvent(eventID)
+	return event.Slots[len(event.Slots)-1], nil
+}
+
+// Remove a candidate slot from an event. The finalized slot and the last slot
+// the meeting fits in cannot be removed.
+func (scheduler) DeleteEventSlot(eventID string, slotID string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, exists := events[eventID]
+	if !exists {
+		return newProblem(problemEventNotFound, "")
+	}
+	index := -1
+	for i, slot := range event.Slots {
+		if slot.ID == slotID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return newProblem(problemSlotNotFound, "")
+	}
+	// The finalized slot cannot be taken away
+	if event.FinalizedSlot != nil && event.FinalizedSlot.ID == slotID {
+		return newProblem(problemSlotFinalized, "Finalize a different slot before deleting this one")
+	}
+	// The event must still have a slot the meeting fits in
+	remaining := append(append([]Slot(nil), event.Slots[:index]...), event.Slots[index+1:]...)
+	if len(remaining) == 0 {
+		return newProblem(problemValidation, "", FieldError{Field: "slots", Message: "at least one slot is required"})
+	}
+	if !fitsAnySlot(remaining, event.EstimatedTime) {
+		return newProblem(problemValidation, "", FieldError{Field: "estimatedTime", Message: "estimatedTime is longer than every slot"})
+	}
+	event.Slots = remaining
+	events[eventID] = event
+	tagEventAvailability(eventID)
+	publishEvent(eventID)
+	return nil
+}
+
+// Get a participant's availability for every event they responded to
+func (scheduler) GetParticipant(participantID string) ([]Participant, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	participant, exists := participants[participantID]
+	if !exists {
+		return nil, newProblem(problemParticipantNotFound, "")
+	}
+	return participant, nil
+}
+
+// Get the availability of the event's participants, followed by anyone else
+// who responded to it. Participants who have not responded have none.
+func (scheduler) EventParticipants(eventID string) ([]eventParticipant, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, exists := events[eventID]
+	if !exists {
+		return nil, newProblem(problemEventNotFound, "")
+	}
+	return eventParticipants(eventID, event), nil
+}
+
+// Get the availability of the event's participants, followed by anyone else
+// who responded to it. The caller must hold mu.
+func eventParticipants(eventID string, event Event) []eventParticipant {
+	var list []eventParticipant
+	for _, participantID := range event.Participants {
+		participant, found := findParticipant(participantID, eventID)
+		list = append(list, eventParticipant{Participant: participant, Responded: found})
+	}
+	var others []eventParticipant
+	for participantID := range participants {
+		if containsString(event.Participants, participantID) {
+			continue
+		}
+		if participant, found := findParticipant(participantID, eventID); found {
+			others = append(others, eventParticipant{Participant: participant, Responded: true})
+		}
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return others[i].ID < others[j].ID
+	})
+	return append(list, others...)
+}
+
+// Get a participant's availability for an event
+func (scheduler) ListParticipantSlots(eventID string, participantID string) (Participant, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, exists := events[eventID]; !exists {
+		return Participant{}, newProblem(problemEventNotFound, "")
+	}
+	participant, found := findParticipant(participantID, eventID)
+	if !found {
+		return Participant{}, newProblem(problemParticipantNotFound, "Participant has no availability for this event")
+	}
+	if participant.Availability == nil {
+		participant.Availability = []Slot{}
+	}
+	return participant, nil
+}
+
+// Replace a participant's availability for an event, creating it if they have
+// not responded yet
+func (scheduler) ReplaceParticipantSlots(eventID string, participantID string, slots []Slot) (availabilityUpdate, error) {
+	return storeResponse(eventID, participantID, slots, anyResponse)
+}
+
+// Record a participant's first response to an event. It is a conflict if they
+// already responded.
+func (scheduler) CreateParticipantSlots(eventID string, participantID string, slots []Slot) (availabilityUpdate, error) {
+	return storeResponse(eventID, participantID, slots, firstResponse)
+}
+
+// Replace the availability a participant already gave for an event
+func (scheduler) UpdateParticipantSlots(eventID string, participantID string, slots []Slot) (availabilityUpdate, error) {
+	return storeResponse(eventID, participantID, slots, laterResponse)
+}
+
+// Which responses replacing a participant's availability accepts
+type responseCondition int
+
+const (
+	anyResponse responseCondition = iota
+	firstResponse
+	laterResponse
+)
+
+// Replace a participant's availability for an event when the condition holds.
+// Every API stores whole availability responses through here.
+func storeResponse(eventID string, participantID string, slots []Slot, condition responseCondition) (availabilityUpdate, error) {
+	if fieldErrors := validateSlots("", slots); len(fieldErrors) > 0 {
+		return availabilityUpdate{}, newProblem(problemValidation, "", fieldErrors...)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, err := openEvent(eventID)
+	if err != nil {
+		return availabilityUpdate{}, err
+	}
+	_, found := findParticipant(participantID, eventID)
+	if condition == firstResponse && found {
+		return availabilityUpdate{}, newProblem(problemAlreadyRecorded, "")
+	}
+	if condition == laterResponse && !found {
+		return availabilityUpdate{}, newProblem(problemParticipantNotFound, "Participant has no availability for this event")
+	}
+	update := storeParticipantSlots(participantID, event, slots)
+	publishAvailability(eventID, participantID)
+	return update, nil
+}
+
+// Merge a slot into a participant's availability for an event
+func (scheduler) AddParticipantSlot(eventID string, participantID string, slot Slot) (availabilityUpdate, error) {
+	if fieldErrors := validateSlot("", slot); len(fieldErrors) > 0 {
+		return availabilityUpdate{}, newProblem(problemValidation, "", fieldErrors...)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, err := openEvent(eventID)
+	if err != nil {
+		return availabilityUpdate{}, err
+	}
+	participant, _ := findParticipant(participantID, eventID)
+	slots := append(append([]Slot(nil), participant.Availability...), slot)
+	update := storeParticipantSlots(participantID, event, slots)
+	publishAvailability(eventID, participantID)
+	return update, nil
+}
+
+// Cut the time between the block's start and end out of a participant's
+// one-off slots for an event
+func (scheduler) RemoveParticipantSlots(eventID string, participantID string, block Slot) (availabilityUpdate, error) {
+	if !block.EndTime.After(block.StartTime) {
+		return availabilityUpdate{}, newProblem(problemValidation, "", FieldError{Field: "end_time", Message: "end_time must be after start_time"})
+	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, err := openEvent(eventID)
+	if err != nil {
+		return availabilityUpdate{}, err
+	}
+	participant, found := findParticipant(participantID, eventID)
+	if !found {
+		return availabilityUpdate{}, newProblem(problemParticipantNotFound, "Participant has no availability for this event")
+	}
+	update := storeParticipantSlots(participantID, event, cutSlots(participant.Availability, block))
+	publishAvailability(eventID, participantID)
+	return update, nil
+}
+
+// Mark the grid cells of the given size that are checked as available for a
+// participant and the rest as not. Only cells whose state changes are touched:
+// checked cells they were not available for are added, and cells they were
+// available for are cut out of their one-off slots. Availability outside the
+// grid or covering only part of a cell, recurring slots and the preferences of
+// cells that stay checked are kept.
+func (scheduler) SetParticipantCells(eventID string, participantID string, interval time.Duration, checked []Slot) (availabilityUpdate, error) {
+	if fieldErrors := validateSlots("slot", checked); len(fieldErrors) > 0 {
+		return availabilityUpdate{}, newProblem(problemValidation, "", fieldErrors...)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, err := openEvent(eventID)
+	if err != nil {
+		return availabilityUpdate{}, err
+	}
+	cells, ok := eventCells(event, interval)
+	if !ok {
+		return availabilityUpdate{}, newProblem(problemInvalidInput, "The event has too many cells for a grid")
+	}
+	participant, _ := findParticipant(participantID, eventID)
+	available := mergeSlots(getEventAvailability(participantID, eventID).Slots)
+	slots := append([]Slot(nil), participant.Availability...)
+	for _, cell := range cells {
+		_, isChecked := findSlot(checked, cell)
+		wasAvailable := coversSlot(available, cell)
+		if isChecked && !wasAvailable {
+			slots = append(slots, cell)
+		} else if !isChecked && wasAvailable {
+			slots = cutSlots(slots, cell)
+		}
+	}
+	update := storeParticipantSlots(participantID, event, slots)
+	publishAvailability(eventID, participantID)
+	return update, nil
+}
+
+// Add the imported participants to an event and replace their availability
+// for it
+func (scheduler) ImportAvailability(eventID string, availability csvAvailability) (availabilityImport, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, err := openEvent(eventID)
+	if err != nil {
+		return availabilityImport{}, err
+	}
+	// Make sure every imported participant is counted as one of the event's
+	// participants, then replace their availability for it
+	for _, participantID := range availability.order {
+		if !containsString(event.Participants, participantID) {
+			event.Participants = append(append([]string(nil), event.Participants...), participantID)
+		}
+	}
+	events[eventID] = event
+	imported := availabilityImport{Participants: len(availability.order)}
+	for _, participantID := range availability.order {
+		update := storeParticipantSlots(participantID, event, availability.slots[participantID])
+		imported.Slots += len(update.Availability)
+		imported.Normalized.Merged += update.Normalized.Merged
+		imported.Normalized.Clipped += update.Normalized.Clipped
+		imported.Normalized.Dropped += update.Normalized.Dropped
+	}
+	for _, participantID := range availability.order {
+		participant, _ := findParticipant(participantID, eventID)
+		broker.Publish(availabilityUpdateMessage(eventID, eventParticipant{Participant: participant, Responded: true}))
+	}
+	publishEvent(eventID)
+	return imported, nil
+}
+
+// Remove a participant and their availability from an event
+func (scheduler) DeleteParticipant(eventID string, participantID string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, err := openEvent(eventID)
+	if err != nil {
+		return err
+	}
+	if _, found := findParticipant(participantID, eventID); !found {
+		return newProblem(problemParticipantNotFound, "Participant has no availability for this event")
+	}
+	removeParticipantAvailability(participantID, eventID)
+	// Build a new list, as events handed out earlier share the old one
+	var remaining []string
+	for _, pid := range event.Participants {
+		if pid != participantID {
+			remaining = append(remaining, pid)
+		}
+	}
+	event.Participants = remaining
+	events[eventID] = event
+	revalidateFinalizedSlot(eventID, nil)
+	publishAvailability(eventID, participantID)
+	publishEvent(eventID)
+	return nil
+}
+
+// Recommend the event's slots that work for the most participants
+func (scheduler) FindCommonSlots(eventID string) (AvailabilityResponse, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	event, exists := events[eventID]
+	if !exists {
+		return AvailabilityResponse{}, newProblem(problemEventNotFound, "")
+	}
+	return commonSlots(eventID, event), nil
+}
+
+// Recommend the event's slots that work for the most participants. The caller
+// must hold mu.
+func commonSlots(eventID string, event Event) AvailabilityResponse {
+	availability := eventAvailability(eventID, event)
+	// Recurring meetings also report how each candidate fares across the series
+	if event.Series != nil {
+		series := recommendSeries(event, availability)
+		return AvailabilityResponse{
+			RecommendedTimeSlots:  recommendSeriesSlots(event, series),
+			SeriesRecommendations: series,
+		}
+	}
+	return AvailabilityResponse{RecommendedTimeSlots: recommendSlots(event, availability)}
+}
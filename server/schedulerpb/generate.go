@@ -0,0 +1,5 @@
+// Package schedulerpb holds the protobuf messages and gRPC services generated
+// from scheduler.proto. Run go generate after changing the proto.
+package schedulerpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative scheduler.proto
@@ -0,0 +1,2105 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: scheduler.proto
+
+// gRPC API for the event scheduler. The services mirror the /v1 REST
+// endpoints and share their logic, so both APIs see the same events.
+
+package schedulerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Slot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set by the server on event slots
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Optional RFC 5545 recurrence rule
+	Rrule string `protobuf:"bytes,4,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	// Start times of occurrences to skip
+	Exdates []*timestamppb.Timestamp `protobuf:"bytes,5,rep,name=exdates,proto3" json:"exdates,omitempty"`
+	// preferred, available or if_needed; empty means available
+	Preference string `protobuf:"bytes,6,opt,name=preference,proto3" json:"preference,omitempty"`
+	// IDs of the event slots an availability slot overlaps
+	SlotIds []string `protobuf:"bytes,7,rep,name=slot_ids,json=slotIds,proto3" json:"slot_ids,omitempty"`
+	// IANA time zone the rrule is expanded in
+	Zone          string `protobuf:"bytes,8,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Slot) Reset() {
+	*x = Slot{}
+	mi := &file_scheduler_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Slot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slot) ProtoMessage() {}
+
+func (x *Slot) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slot.ProtoReflect.Descriptor instead.
+func (*Slot) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Slot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Slot) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Slot) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Slot) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *Slot) GetExdates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Exdates
+	}
+	return nil
+}
+
+func (x *Slot) GetPreference() string {
+	if x != nil {
+		return x.Preference
+	}
+	return ""
+}
+
+func (x *Slot) GetSlotIds() []string {
+	if x != nil {
+		return x.SlotIds
+	}
+	return nil
+}
+
+func (x *Slot) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+type SeriesOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IntervalWeeks int32                  `protobuf:"varint,1,opt,name=interval_weeks,json=intervalWeeks,proto3" json:"interval_weeks,omitempty"`
+	Occurrences   int32                  `protobuf:"varint,2,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	Zone          string                 `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesOptions) Reset() {
+	*x = SeriesOptions{}
+	mi := &file_scheduler_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesOptions) ProtoMessage() {}
+
+func (x *SeriesOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesOptions.ProtoReflect.Descriptor instead.
+func (*SeriesOptions) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SeriesOptions) GetIntervalWeeks() int32 {
+	if x != nil {
+		return x.IntervalWeeks
+	}
+	return 0
+}
+
+func (x *SeriesOptions) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *SeriesOptions) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+type Event struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Slots            []*Slot                `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+	EstimatedTime    *durationpb.Duration   `protobuf:"bytes,4,opt,name=estimated_time,json=estimatedTime,proto3" json:"estimated_time,omitempty"`
+	Participants     []string               `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
+	Organizer        string                 `protobuf:"bytes,6,opt,name=organizer,proto3" json:"organizer,omitempty"`
+	Horizon          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=horizon,proto3" json:"horizon,omitempty"`
+	Series           *SeriesOptions         `protobuf:"bytes,8,opt,name=series,proto3" json:"series,omitempty"`
+	ResponseDeadline *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=response_deadline,json=responseDeadline,proto3" json:"response_deadline,omitempty"`
+	AutoFinalize     bool                   `protobuf:"varint,10,opt,name=auto_finalize,json=autoFinalize,proto3" json:"auto_finalize,omitempty"`
+	// Set by the server
+	Status        string   `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	FinalizedSlot *Slot    `protobuf:"bytes,12,opt,name=finalized_slot,json=finalizedSlot,proto3" json:"finalized_slot,omitempty"`
+	AtRisk        bool     `protobuf:"varint,13,opt,name=at_risk,json=atRisk,proto3" json:"at_risk,omitempty"`
+	Conflicts     []string `protobuf:"bytes,14,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_scheduler_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Event) GetSlots() []*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Event) GetEstimatedTime() *durationpb.Duration {
+	if x != nil {
+		return x.EstimatedTime
+	}
+	return nil
+}
+
+func (x *Event) GetParticipants() []string {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *Event) GetOrganizer() string {
+	if x != nil {
+		return x.Organizer
+	}
+	return ""
+}
+
+func (x *Event) GetHorizon() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Horizon
+	}
+	return nil
+}
+
+func (x *Event) GetSeries() *SeriesOptions {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *Event) GetResponseDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResponseDeadline
+	}
+	return nil
+}
+
+func (x *Event) GetAutoFinalize() bool {
+	if x != nil {
+		return x.AutoFinalize
+	}
+	return false
+}
+
+func (x *Event) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Event) GetFinalizedSlot() *Slot {
+	if x != nil {
+		return x.FinalizedSlot
+	}
+	return nil
+}
+
+func (x *Event) GetAtRisk() bool {
+	if x != nil {
+		return x.AtRisk
+	}
+	return false
+}
+
+func (x *Event) GetConflicts() []string {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type CreateEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
+	mi := &file_scheduler_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRequest) ProtoMessage() {}
+
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateEventRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type GetEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	mi := &file_scheduler_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRequest) ProtoMessage() {}
+
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_scheduler_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{5}
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_scheduler_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type UpdateEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event         *Event                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	mi := &file_scheduler_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type PatchEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// RFC 7396 merge patch of the event's REST JSON form, using its field names
+	// such as start_time and estimatedTime; null members are cleared
+	Patch         *structpb.Struct `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchEventRequest) Reset() {
+	*x = PatchEventRequest{}
+	mi := &file_scheduler_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchEventRequest) ProtoMessage() {}
+
+func (x *PatchEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchEventRequest.ProtoReflect.Descriptor instead.
+func (*PatchEventRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PatchEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchEventRequest) GetPatch() *structpb.Struct {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+type DeleteEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventRequest) Reset() {
+	*x = DeleteEventRequest{}
+	mi := &file_scheduler_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventRequest) ProtoMessage() {}
+
+func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FinalizeEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The slot's times, or just the ID of one of the event's slots
+	Slot          *Slot `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinalizeEventRequest) Reset() {
+	*x = FinalizeEventRequest{}
+	mi := &file_scheduler_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeEventRequest) ProtoMessage() {}
+
+func (x *FinalizeEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeEventRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeEventRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FinalizeEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FinalizeEventRequest) GetSlot() *Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+type ListEventSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventSlotsRequest) Reset() {
+	*x = ListEventSlotsRequest{}
+	mi := &file_scheduler_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventSlotsRequest) ProtoMessage() {}
+
+func (x *ListEventSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventSlotsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListEventSlotsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListEventSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*Slot                `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventSlotsResponse) Reset() {
+	*x = ListEventSlotsResponse{}
+	mi := &file_scheduler_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventSlotsResponse) ProtoMessage() {}
+
+func (x *ListEventSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventSlotsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListEventSlotsResponse) GetSlots() []*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type AddEventSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slot          *Slot                  `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEventSlotRequest) Reset() {
+	*x = AddEventSlotRequest{}
+	mi := &file_scheduler_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEventSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEventSlotRequest) ProtoMessage() {}
+
+func (x *AddEventSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEventSlotRequest.ProtoReflect.Descriptor instead.
+func (*AddEventSlotRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddEventSlotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddEventSlotRequest) GetSlot() *Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+type DeleteEventSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SlotId        string                 `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventSlotRequest) Reset() {
+	*x = DeleteEventSlotRequest{}
+	mi := &file_scheduler_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventSlotRequest) ProtoMessage() {}
+
+func (x *DeleteEventSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventSlotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventSlotRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteEventSlotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteEventSlotRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+type ParticipantAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Availability  []*Slot                `protobuf:"bytes,3,rep,name=availability,proto3" json:"availability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantAvailability) Reset() {
+	*x = ParticipantAvailability{}
+	mi := &file_scheduler_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantAvailability) ProtoMessage() {}
+
+func (x *ParticipantAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantAvailability.ProtoReflect.Descriptor instead.
+func (*ParticipantAvailability) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ParticipantAvailability) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ParticipantAvailability) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ParticipantAvailability) GetAvailability() []*Slot {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+type AvailabilityNormalization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merged        int32                  `protobuf:"varint,1,opt,name=merged,proto3" json:"merged,omitempty"`
+	Clipped       int32                  `protobuf:"varint,2,opt,name=clipped,proto3" json:"clipped,omitempty"`
+	Dropped       int32                  `protobuf:"varint,3,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityNormalization) Reset() {
+	*x = AvailabilityNormalization{}
+	mi := &file_scheduler_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityNormalization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityNormalization) ProtoMessage() {}
+
+func (x *AvailabilityNormalization) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityNormalization.ProtoReflect.Descriptor instead.
+func (*AvailabilityNormalization) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AvailabilityNormalization) GetMerged() int32 {
+	if x != nil {
+		return x.Merged
+	}
+	return 0
+}
+
+func (x *AvailabilityNormalization) GetClipped() int32 {
+	if x != nil {
+		return x.Clipped
+	}
+	return 0
+}
+
+func (x *AvailabilityNormalization) GetDropped() int32 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type AvailabilityUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The availability as stored, after merging and clipping
+	Availability []*Slot                    `protobuf:"bytes,1,rep,name=availability,proto3" json:"availability,omitempty"`
+	Normalized   *AvailabilityNormalization `protobuf:"bytes,2,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	// True when this was the participant's first response to the event
+	Created       bool `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityUpdate) Reset() {
+	*x = AvailabilityUpdate{}
+	mi := &file_scheduler_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityUpdate) ProtoMessage() {}
+
+func (x *AvailabilityUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityUpdate.ProtoReflect.Descriptor instead.
+func (*AvailabilityUpdate) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AvailabilityUpdate) GetAvailability() []*Slot {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *AvailabilityUpdate) GetNormalized() *AvailabilityNormalization {
+	if x != nil {
+		return x.Normalized
+	}
+	return nil
+}
+
+func (x *AvailabilityUpdate) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type GetParticipantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetParticipantRequest) Reset() {
+	*x = GetParticipantRequest{}
+	mi := &file_scheduler_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParticipantRequest) ProtoMessage() {}
+
+func (x *GetParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParticipantRequest.ProtoReflect.Descriptor instead.
+func (*GetParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetParticipantRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+type GetParticipantResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Events        []*ParticipantAvailability `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetParticipantResponse) Reset() {
+	*x = GetParticipantResponse{}
+	mi := &file_scheduler_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParticipantResponse) ProtoMessage() {}
+
+func (x *GetParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParticipantResponse.ProtoReflect.Descriptor instead.
+func (*GetParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetParticipantResponse) GetEvents() []*ParticipantAvailability {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ListParticipantSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListParticipantSlotsRequest) Reset() {
+	*x = ListParticipantSlotsRequest{}
+	mi := &file_scheduler_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListParticipantSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListParticipantSlotsRequest) ProtoMessage() {}
+
+func (x *ListParticipantSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListParticipantSlotsRequest.ProtoReflect.Descriptor instead.
+func (*ListParticipantSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListParticipantSlotsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListParticipantSlotsRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+type ReplaceParticipantSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Slots         []*Slot                `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceParticipantSlotsRequest) Reset() {
+	*x = ReplaceParticipantSlotsRequest{}
+	mi := &file_scheduler_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceParticipantSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceParticipantSlotsRequest) ProtoMessage() {}
+
+func (x *ReplaceParticipantSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceParticipantSlotsRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceParticipantSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReplaceParticipantSlotsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReplaceParticipantSlotsRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ReplaceParticipantSlotsRequest) GetSlots() []*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type AddParticipantSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Slot          *Slot                  `protobuf:"bytes,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddParticipantSlotRequest) Reset() {
+	*x = AddParticipantSlotRequest{}
+	mi := &file_scheduler_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddParticipantSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddParticipantSlotRequest) ProtoMessage() {}
+
+func (x *AddParticipantSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddParticipantSlotRequest.ProtoReflect.Descriptor instead.
+func (*AddParticipantSlotRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddParticipantSlotRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AddParticipantSlotRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *AddParticipantSlotRequest) GetSlot() *Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+type RemoveParticipantSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveParticipantSlotsRequest) Reset() {
+	*x = RemoveParticipantSlotsRequest{}
+	mi := &file_scheduler_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveParticipantSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveParticipantSlotsRequest) ProtoMessage() {}
+
+func (x *RemoveParticipantSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveParticipantSlotsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveParticipantSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveParticipantSlotsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RemoveParticipantSlotsRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *RemoveParticipantSlotsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *RemoveParticipantSlotsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type DeleteParticipantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteParticipantRequest) Reset() {
+	*x = DeleteParticipantRequest{}
+	mi := &file_scheduler_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteParticipantRequest) ProtoMessage() {}
+
+func (x *DeleteParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteParticipantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteParticipantRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *DeleteParticipantRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+type ImportAvailabilityRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// CSV with participant_id, start and end columns and optional zone and
+	// preference columns, at most 10 MB
+	Csv           []byte `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportAvailabilityRequest) Reset() {
+	*x = ImportAvailabilityRequest{}
+	mi := &file_scheduler_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAvailabilityRequest) ProtoMessage() {}
+
+func (x *ImportAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*ImportAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportAvailabilityRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ImportAvailabilityRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+type ImportAvailabilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of participants and slots imported
+	Participants  int32                      `protobuf:"varint,1,opt,name=participants,proto3" json:"participants,omitempty"`
+	Slots         int32                      `protobuf:"varint,2,opt,name=slots,proto3" json:"slots,omitempty"`
+	Normalized    *AvailabilityNormalization `protobuf:"bytes,3,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportAvailabilityResponse) Reset() {
+	*x = ImportAvailabilityResponse{}
+	mi := &file_scheduler_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAvailabilityResponse) ProtoMessage() {}
+
+func (x *ImportAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*ImportAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportAvailabilityResponse) GetParticipants() int32 {
+	if x != nil {
+		return x.Participants
+	}
+	return 0
+}
+
+func (x *ImportAvailabilityResponse) GetSlots() int32 {
+	if x != nil {
+		return x.Slots
+	}
+	return 0
+}
+
+func (x *ImportAvailabilityResponse) GetNormalized() *AvailabilityNormalization {
+	if x != nil {
+		return x.Normalized
+	}
+	return nil
+}
+
+type FindCommonSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindCommonSlotsRequest) Reset() {
+	*x = FindCommonSlotsRequest{}
+	mi := &file_scheduler_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindCommonSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindCommonSlotsRequest) ProtoMessage() {}
+
+func (x *FindCommonSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindCommonSlotsRequest.ProtoReflect.Descriptor instead.
+func (*FindCommonSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FindCommonSlotsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type SlotRecommendation struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Slot                    *Slot                  `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	UnavailableParticipants []string               `protobuf:"bytes,2,rep,name=unavailable_participants,json=unavailableParticipants,proto3" json:"unavailable_participants,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *SlotRecommendation) Reset() {
+	*x = SlotRecommendation{}
+	mi := &file_scheduler_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotRecommendation) ProtoMessage() {}
+
+func (x *SlotRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotRecommendation.ProtoReflect.Descriptor instead.
+func (*SlotRecommendation) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SlotRecommendation) GetSlot() *Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *SlotRecommendation) GetUnavailableParticipants() []string {
+	if x != nil {
+		return x.UnavailableParticipants
+	}
+	return nil
+}
+
+type SeriesRecommendation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slot  *Slot                  `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Score int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	// Number of occurrences each participant can attend
+	Attendance    map[string]int32      `protobuf:"bytes,3,rep,name=attendance,proto3" json:"attendance,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Occurrences   []*SlotRecommendation `protobuf:"bytes,4,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesRecommendation) Reset() {
+	*x = SeriesRecommendation{}
+	mi := &file_scheduler_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesRecommendation) ProtoMessage() {}
+
+func (x *SeriesRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesRecommendation.ProtoReflect.Descriptor instead.
+func (*SeriesRecommendation) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SeriesRecommendation) GetSlot() *Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *SeriesRecommendation) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SeriesRecommendation) GetAttendance() map[string]int32 {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *SeriesRecommendation) GetOccurrences() []*SlotRecommendation {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+type FindCommonSlotsResponse struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	RecommendedTimeSlots  []*SlotRecommendation   `protobuf:"bytes,1,rep,name=recommended_time_slots,json=recommendedTimeSlots,proto3" json:"recommended_time_slots,omitempty"`
+	SeriesRecommendations []*SeriesRecommendation `protobuf:"bytes,2,rep,name=series_recommendations,json=seriesRecommendations,proto3" json:"series_recommendations,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *FindCommonSlotsResponse) Reset() {
+	*x = FindCommonSlotsResponse{}
+	mi := &file_scheduler_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindCommonSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindCommonSlotsResponse) ProtoMessage() {}
+
+func (x *FindCommonSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduler_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindCommonSlotsResponse.ProtoReflect.Descriptor instead.
+func (*FindCommonSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_scheduler_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FindCommonSlotsResponse) GetRecommendedTimeSlots() []*SlotRecommendation {
+	if x != nil {
+		return x.RecommendedTimeSlots
+	}
+	return nil
+}
+
+func (x *FindCommonSlotsResponse) GetSeriesRecommendations() []*SeriesRecommendation {
+	if x != nil {
+		return x.SeriesRecommendations
+	}
+	return nil
+}
+
+var File_scheduler_proto protoreflect.FileDescriptor
+
+const file_scheduler_proto_rawDesc = "" +
+	"\n" +
+	"\x0fscheduler.proto\x12\fscheduler.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa3\x02\n" +
+	"\x04Slot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05rrule\x18\x04 \x01(\tR\x05rrule\x124\n" +
+	"\aexdates\x18\x05 \x03(\v2\x1a.google.protobuf.TimestampR\aexdates\x12\x1e\n" +
+	"\n" +
+	"preference\x18\x06 \x01(\tR\n" +
+	"preference\x12\x19\n" +
+	"\bslot_ids\x18\a \x03(\tR\aslotIds\x12\x12\n" +
+	"\x04zone\x18\b \x01(\tR\x04zone\"l\n" +
+	"\rSeriesOptions\x12%\n" +
+	"\x0einterval_weeks\x18\x01 \x01(\x05R\rintervalWeeks\x12 \n" +
+	"\voccurrences\x18\x02 \x01(\x05R\voccurrences\x12\x12\n" +
+	"\x04zone\x18\x03 \x01(\tR\x04zone\"\xbe\x04\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12(\n" +
+	"\x05slots\x18\x03 \x03(\v2\x12.scheduler.v1.SlotR\x05slots\x12@\n" +
+	"\x0eestimated_time\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\restimatedTime\x12\"\n" +
+	"\fparticipants\x18\x05 \x03(\tR\fparticipants\x12\x1c\n" +
+	"\torganizer\x18\x06 \x01(\tR\torganizer\x124\n" +
+	"\ahorizon\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ahorizon\x123\n" +
+	"\x06series\x18\b \x01(\v2\x1b.scheduler.v1.SeriesOptionsR\x06series\x12G\n" +
+	"\x11response_deadline\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x10responseDeadline\x12#\n" +
+	"\rauto_finalize\x18\n" +
+	" \x01(\bR\fautoFinalize\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x129\n" +
+	"\x0efinalized_slot\x18\f \x01(\v2\x12.scheduler.v1.SlotR\rfinalizedSlot\x12\x17\n" +
+	"\aat_risk\x18\r \x01(\bR\x06atRisk\x12\x1c\n" +
+	"\tconflicts\x18\x0e \x03(\tR\tconflicts\"?\n" +
+	"\x12CreateEventRequest\x12)\n" +
+	"\x05event\x18\x01 \x01(\v2\x13.scheduler.v1.EventR\x05event\"!\n" +
+	"\x0fGetEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x13\n" +
+	"\x11ListEventsRequest\"A\n" +
+	"\x12ListEventsResponse\x12+\n" +
+	"\x06events\x18\x01 \x03(\v2\x13.scheduler.v1.EventR\x06events\"O\n" +
+	"\x12UpdateEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x05event\x18\x02 \x01(\v2\x13.scheduler.v1.EventR\x05event\"R\n" +
+	"\x11PatchEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x05patch\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x05patch\"$\n" +
+	"\x12DeleteEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"N\n" +
+	"\x14FinalizeEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x04slot\x18\x02 \x01(\v2\x12.scheduler.v1.SlotR\x04slot\"'\n" +
+	"\x15ListEventSlotsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x16ListEventSlotsResponse\x12(\n" +
+	"\x05slots\x18\x01 \x03(\v2\x12.scheduler.v1.SlotR\x05slots\"M\n" +
+	"\x13AddEventSlotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x04slot\x18\x02 \x01(\v2\x12.scheduler.v1.SlotR\x04slot\"A\n" +
+	"\x16DeleteEventSlotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aslot_id\x18\x02 \x01(\tR\x06slotId\"\x93\x01\n" +
+	"\x17ParticipantAvailability\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x126\n" +
+	"\favailability\x18\x03 \x03(\v2\x12.scheduler.v1.SlotR\favailability\"g\n" +
+	"\x19AvailabilityNormalization\x12\x16\n" +
+	"\x06merged\x18\x01 \x01(\x05R\x06merged\x12\x18\n" +
+	"\aclipped\x18\x02 \x01(\x05R\aclipped\x12\x18\n" +
+	"\adropped\x18\x03 \x01(\x05R\adropped\"\xaf\x01\n" +
+	"\x12AvailabilityUpdate\x126\n" +
+	"\favailability\x18\x01 \x03(\v2\x12.scheduler.v1.SlotR\favailability\x12G\n" +
+	"\n" +
+	"normalized\x18\x02 \x01(\v2'.scheduler.v1.AvailabilityNormalizationR\n" +
+	"normalized\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\bR\acreated\">\n" +
+	"\x15GetParticipantRequest\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\"W\n" +
+	"\x16GetParticipantResponse\x12=\n" +
+	"\x06events\x18\x01 \x03(\v2%.scheduler.v1.ParticipantAvailabilityR\x06events\"_\n" +
+	"\x1bListParticipantSlotsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\"\x8c\x01\n" +
+	"\x1eReplaceParticipantSlotsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12(\n" +
+	"\x05slots\x18\x03 \x03(\v2\x12.scheduler.v1.SlotR\x05slots\"\x85\x01\n" +
+	"\x19AddParticipantSlotRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12&\n" +
+	"\x04slot\x18\x03 \x01(\v2\x12.scheduler.v1.SlotR\x04slot\"\xd3\x01\n" +
+	"\x1dRemoveParticipantSlotsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\\\n" +
+	"\x18DeleteParticipantRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\"H\n" +
+	"\x19ImportAvailabilityRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x10\n" +
+	"\x03csv\x18\x02 \x01(\fR\x03csv\"\x9f\x01\n" +
+	"\x1aImportAvailabilityResponse\x12\"\n" +
+	"\fparticipants\x18\x01 \x01(\x05R\fparticipants\x12\x14\n" +
+	"\x05slots\x18\x02 \x01(\x05R\x05slots\x12G\n" +
+	"\n" +
+	"normalized\x18\x03 \x01(\v2'.scheduler.v1.AvailabilityNormalizationR\n" +
+	"normalized\"3\n" +
+	"\x16FindCommonSlotsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"w\n" +
+	"\x12SlotRecommendation\x12&\n" +
+	"\x04slot\x18\x01 \x01(\v2\x12.scheduler.v1.SlotR\x04slot\x129\n" +
+	"\x18unavailable_participants\x18\x02 \x03(\tR\x17unavailableParticipants\"\xab\x02\n" +
+	"\x14SeriesRecommendation\x12&\n" +
+	"\x04slot\x18\x01 \x01(\v2\x12.scheduler.v1.SlotR\x04slot\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\x12R\n" +
+	"\n" +
+	"attendance\x18\x03 \x03(\v22.scheduler.v1.SeriesRecommendation.AttendanceEntryR\n" +
+	"attendance\x12B\n" +
+	"\voccurrences\x18\x04 \x03(\v2 .scheduler.v1.SlotRecommendationR\voccurrences\x1a=\n" +
+	"\x0fAttendanceEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xcc\x01\n" +
+	"\x17FindCommonSlotsResponse\x12V\n" +
+	"\x16recommended_time_slots\x18\x01 \x03(\v2 .scheduler.v1.SlotRecommendationR\x14recommendedTimeSlots\x12Y\n" +
+	"\x16series_recommendations\x18\x02 \x03(\v2\".scheduler.v1.SeriesRecommendationR\x15seriesRecommendations2\xf7\x05\n" +
+	"\fEventService\x12D\n" +
+	"\vCreateEvent\x12 .scheduler.v1.CreateEventRequest\x1a\x13.scheduler.v1.Event\x12>\n" +
+	"\bGetEvent\x12\x1d.scheduler.v1.GetEventRequest\x1a\x13.scheduler.v1.Event\x12O\n" +
+	"\n" +
+	"ListEvents\x12\x1f.scheduler.v1.ListEventsRequest\x1a .scheduler.v1.ListEventsResponse\x12D\n" +
+	"\vUpdateEvent\x12 .scheduler.v1.UpdateEventRequest\x1a\x13.scheduler.v1.Event\x12B\n" +
+	"\n" +
+	"PatchEvent\x12\x1f.scheduler.v1.PatchEventRequest\x1a\x13.scheduler.v1.Event\x12G\n" +
+	"\vDeleteEvent\x12 .scheduler.v1.DeleteEventRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\rFinalizeEvent\x12\".scheduler.v1.FinalizeEventRequest\x1a\x13.scheduler.v1.Event\x12[\n" +
+	"\x0eListEventSlots\x12#.scheduler.v1.ListEventSlotsRequest\x1a$.scheduler.v1.ListEventSlotsResponse\x12E\n" +
+	"\fAddEventSlot\x12!.scheduler.v1.AddEventSlotRequest\x1a\x12.scheduler.v1.Slot\x12O\n" +
+	"\x0fDeleteEventSlot\x12$.scheduler.v1.DeleteEventSlotRequest\x1a\x16.google.protobuf.Empty2\xcf\x05\n" +
+	"\x13AvailabilityService\x12[\n" +
+	"\x0eGetParticipant\x12#.scheduler.v1.GetParticipantRequest\x1a$.scheduler.v1.GetParticipantResponse\x12h\n" +
+	"\x14ListParticipantSlots\x12).scheduler.v1.ListParticipantSlotsRequest\x1a%.scheduler.v1.ParticipantAvailability\x12i\n" +
+	"\x17ReplaceParticipantSlots\x12,.scheduler.v1.ReplaceParticipantSlotsRequest\x1a .scheduler.v1.AvailabilityUpdate\x12_\n" +
+	"\x12AddParticipantSlot\x12'.scheduler.v1.AddParticipantSlotRequest\x1a .scheduler.v1.AvailabilityUpdate\x12g\n" +
+	"\x16RemoveParticipantSlots\x12+.scheduler.v1.RemoveParticipantSlotsRequest\x1a .scheduler.v1.AvailabilityUpdate\x12S\n" +
+	"\x11DeleteParticipant\x12&.scheduler.v1.DeleteParticipantRequest\x1a\x16.google.protobuf.Empty\x12g\n" +
+	"\x12ImportAvailability\x12'.scheduler.v1.ImportAvailabilityRequest\x1a(.scheduler.v1.ImportAvailabilityResponse2w\n" +
+	"\x15RecommendationService\x12^\n" +
+	"\x0fFindCommonSlots\x12$.scheduler.v1.FindCommonSlotsRequest\x1a%.scheduler.v1.FindCommonSlotsResponseB5Z3github.com/deepakg86/go-event-scheduler/schedulerpbb\x06proto3"
+
+var (
+	file_scheduler_proto_rawDescOnce sync.Once
+	file_scheduler_proto_rawDescData []byte
+)
+
+func file_scheduler_proto_rawDescGZIP() []byte {
+	file_scheduler_proto_rawDescOnce.Do(func() {
+		file_scheduler_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_scheduler_proto_rawDesc), len(file_scheduler_proto_rawDesc)))
+	})
+	return file_scheduler_proto_rawDescData
+}
+
+var file_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_scheduler_proto_goTypes = []any{
+	(*Slot)(nil),                           // 0: scheduler.v1.Slot
+	(*SeriesOptions)(nil),                  // 1: scheduler.v1.SeriesOptions
+	(*Event)(nil),                          // 2: scheduler.v1.Event
+	(*CreateEventRequest)(nil),             // 3: scheduler.v1.CreateEventRequest
+	(*GetEventRequest)(nil),                // 4: scheduler.v1.GetEventRequest
+	(*ListEventsRequest)(nil),              // 5: scheduler.v1.ListEventsRequest
+	(*ListEventsResponse)(nil),             // 6: scheduler.v1.ListEventsResponse
+	(*UpdateEventRequest)(nil),             // 7: scheduler.v1.UpdateEventRequest
+	(*PatchEventRequest)(nil),              // 8: scheduler.v1.PatchEventRequest
+	(*DeleteEventRequest)(nil),             // 9: scheduler.v1.DeleteEventRequest
+	(*FinalizeEventRequest)(nil),           // 10: scheduler.v1.FinalizeEventRequest
+	(*ListEventSlotsRequest)(nil),          // 11: scheduler.v1.ListEventSlotsRequest
+	(*ListEventSlotsResponse)(nil),         // 12: scheduler.v1.ListEventSlotsResponse
+	(*AddEventSlotRequest)(nil),            // 13: scheduler.v1.AddEventSlotRequest
+	(*DeleteEventSlotRequest)(nil),         // 14: scheduler.v1.DeleteEventSlotRequest
+	(*ParticipantAvailability)(nil),        // 15: scheduler.v1.ParticipantAvailability
+	(*AvailabilityNormalization)(nil),      // 16: scheduler.v1.AvailabilityNormalization
+	(*AvailabilityUpdate)(nil),             // 17: scheduler.v1.AvailabilityUpdate
+	(*GetParticipantRequest)(nil),          // 18: scheduler.v1.GetParticipantRequest
+	(*GetParticipantResponse)(nil),         // 19: scheduler.v1.GetParticipantResponse
+	(*ListParticipantSlotsRequest)(nil),    // 20: scheduler.v1.ListParticipantSlotsRequest
+	(*ReplaceParticipantSlotsRequest)(nil), // 21: scheduler.v1.ReplaceParticipantSlotsRequest
+	(*AddParticipantSlotRequest)(nil),      // 22: scheduler.v1.AddParticipantSlotRequest
+	(*RemoveParticipantSlotsRequest)(nil),  // 23: scheduler.v1.RemoveParticipantSlotsRequest
+	(*DeleteParticipantRequest)(nil),       // 24: scheduler.v1.DeleteParticipantRequest
+	(*ImportAvailabilityRequest)(nil),      // 25: scheduler.v1.ImportAvailabilityRequest
+	(*ImportAvailabilityResponse)(nil),     // 26: scheduler.v1.ImportAvailabilityResponse
+	(*FindCommonSlotsRequest)(nil),         // 27: scheduler.v1.FindCommonSlotsRequest
+	(*SlotRecommendation)(nil),             // 28: scheduler.v1.SlotRecommendation
+	(*SeriesRecommendation)(nil),           // 29: scheduler.v1.SeriesRecommendation
+	(*FindCommonSlotsResponse)(nil),        // 30: scheduler.v1.FindCommonSlotsResponse
+	nil,                                    // 31: scheduler.v1.SeriesRecommendation.AttendanceEntry
+	(*timestamppb.Timestamp)(nil),          // 32: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 33: google.protobuf.Duration
+	(*structpb.Struct)(nil),                // 34: google.protobuf.Struct
+	(*emptypb.Empty)(nil),                  // 35: google.protobuf.Empty
+}
+var file_scheduler_proto_depIdxs = []int32{
+	32, // 0: scheduler.v1.Slot.start_time:type_name -> google.protobuf.Timestamp
+	32, // 1: scheduler.v1.Slot.end_time:type_name -> google.protobuf.Timestamp
+	32, // 2: scheduler.v1.Slot.exdates:type_name -> google.protobuf.Timestamp
+	0,  // 3: scheduler.v1.Event.slots:type_name -> scheduler.v1.Slot
+	33, // 4: scheduler.v1.Event.estimated_time:type_name -> google.protobuf.Duration
+	32, // 5: scheduler.v1.Event.horizon:type_name -> google.protobuf.Timestamp
+	1,  // 6: scheduler.v1.Event.series:type_name -> scheduler.v1.SeriesOptions
+	32, // 7: scheduler.v1.Event.response_deadline:type_name -> google.protobuf.Timestamp
+	0,  // 8: scheduler.v1.Event.finalized_slot:type_name -> scheduler.v1.Slot
+	2,  // 9: scheduler.v1.CreateEventRequest.event:type_name -> scheduler.v1.Event
+	2,  // 10: scheduler.v1.ListEventsResponse.events:type_name -> scheduler.v1.Event
+	2,  // 11: scheduler.v1.UpdateEventRequest.event:type_name -> scheduler.v1.Event
+	34, // 12: scheduler.v1.PatchEventRequest.patch:type_name -> google.protobuf.Struct
+	0,  // 13: scheduler.v1.FinalizeEventRequest.slot:type_name -> scheduler.v1.Slot
+	0,  // 14: scheduler.v1.ListEventSlotsResponse.slots:type_name -> scheduler.v1.Slot
+	0,  // 15: scheduler.v1.AddEventSlotRequest.slot:type_name -> scheduler.v1.Slot
+	0,  // 16: scheduler.v1.ParticipantAvailability.availability:type_name -> scheduler.v1.Slot
+	0,  // 17: scheduler.v1.AvailabilityUpdate.availability:type_name -> scheduler.v1.Slot
+	16, // 18: scheduler.v1.AvailabilityUpdate.normalized:type_name -> scheduler.v1.AvailabilityNormalization
+	15, // 19: scheduler.v1.GetParticipantResponse.events:type_name -> scheduler.v1.ParticipantAvailability
+	0,  // 20: scheduler.v1.ReplaceParticipantSlotsRequest.slots:type_name -> scheduler.v1.Slot
+	0,  // 21: scheduler.v1.AddParticipantSlotRequest.slot:type_name -> scheduler.v1.Slot
+	32, // 22: scheduler.v1.RemoveParticipantSlotsRequest.start_time:type_name -> google.protobuf.Timestamp
+	32, // 23: scheduler.v1.RemoveParticipantSlotsRequest.end_time:type_name -> google.protobuf.Timestamp
+	16, // 24: scheduler.v1.ImportAvailabilityResponse.normalized:type_name -> scheduler.v1.AvailabilityNormalization
+	0,  // 25: scheduler.v1.SlotRecommendation.slot:type_name -> scheduler.v1.Slot
+	0,  // 26: scheduler.v1.SeriesRecommendation.slot:type_name -> scheduler.v1.Slot
+	31, // 27: scheduler.v1.SeriesRecommendation.attendance:type_name -> scheduler.v1.SeriesRecommendation.AttendanceEntry
+	28, // 28: scheduler.v1.SeriesRecommendation.occurrences:type_name -> scheduler.v1.SlotRecommendation
+	28, // 29: scheduler.v1.FindCommonSlotsResponse.recommended_time_slots:type_name -> scheduler.v1.SlotRecommendation
+	29, // 30: scheduler.v1.FindCommonSlotsResponse.series_recommendations:type_name -> scheduler.v1.SeriesRecommendation
+	3,  // 31: scheduler.v1.EventService.CreateEvent:input_type -> scheduler.v1.CreateEventRequest
+	4,  // 32: scheduler.v1.EventService.GetEvent:input_type -> scheduler.v1.GetEventRequest
+	5,  // 33: scheduler.v1.EventService.ListEvents:input_type -> scheduler.v1.ListEventsRequest
+	7,  // 34: scheduler.v1.EventService.UpdateEvent:input_type -> scheduler.v1.UpdateEventRequest
+	8,  // 35: scheduler.v1.EventService.PatchEvent:input_type -> scheduler.v1.PatchEventRequest
+	9,  // 36: scheduler.v1.EventService.DeleteEvent:input_type -> scheduler.v1.DeleteEventRequest
+	10, // 37: scheduler.v1.EventService.FinalizeEvent:input_type -> scheduler.v1.FinalizeEventRequest
+	11, // 38: scheduler.v1.EventService.ListEventSlots:input_type -> scheduler.v1.ListEventSlotsRequest
+	13, // 39: scheduler.v1.EventService.AddEventSlot:input_type -> scheduler.v1.AddEventSlotRequest
+	14, // 40: scheduler.v1.EventService.DeleteEventSlot:input_type -> scheduler.v1.DeleteEventSlotRequest
+	18, // 41: scheduler.v1.AvailabilityService.GetParticipant:input_type -> scheduler.v1.GetParticipantRequest
+	20, // 42: scheduler.v1.AvailabilityService.ListParticipantSlots:input_type -> scheduler.v1.ListParticipantSlotsRequest
+	21, // 43: scheduler.v1.AvailabilityService.ReplaceParticipantSlots:input_type -> scheduler.v1.ReplaceParticipantSlotsRequest
+	22, // 44: scheduler.v1.AvailabilityService.AddParticipantSlot:input_type -> scheduler.v1.AddParticipantSlotRequest
+	23, // 45: scheduler.v1.AvailabilityService.RemoveParticipantSlots:input_type -> scheduler.v1.RemoveParticipantSlotsRequest
+	24, // 46: scheduler.v1.AvailabilityService.DeleteParticipant:input_type -> scheduler.v1.DeleteParticipantRequest
+	25, // 47: scheduler.v1.AvailabilityService.ImportAvailability:input_type -> scheduler.v1.ImportAvailabilityRequest
+	27, // 48: scheduler.v1.RecommendationService.FindCommonSlots:input_type -> scheduler.v1.FindCommonSlotsRequest
+	2,  // 49: scheduler.v1.EventService.CreateEvent:output_type -> scheduler.v1.Event
+	2,  // 50: scheduler.v1.EventService.GetEvent:output_type -> scheduler.v1.Event
+	6,  // 51: scheduler.v1.EventService.ListEvents:output_type -> scheduler.v1.ListEventsResponse
+	2,  // 52: scheduler.v1.EventService.UpdateEvent:output_type -> scheduler.v1.Event
+	2,  // 53: scheduler.v1.EventService.PatchEvent:output_type -> scheduler.v1.Event
+	35, // 54: scheduler.v1.EventService.DeleteEvent:output_type -> google.protobuf.Empty
+	2,  // 55: scheduler.v1.EventService.FinalizeEvent:output_type -> scheduler.v1.Event
+	12, // 56: scheduler.v1.EventService.ListEventSlots:output_type -> scheduler.v1.ListEventSlotsResponse
+	0,  // 57: scheduler.v1.EventService.AddEventSlot:output_type -> scheduler.v1.Slot
+	35, // 58: scheduler.v1.EventService.DeleteEventSlot:output_type -> google.protobuf.Empty
+	19, // 59: scheduler.v1.AvailabilityService.GetParticipant:output_type -> scheduler.v1.GetParticipantResponse
+	15, // 60: scheduler.v1.AvailabilityService.ListParticipantSlots:output_type -> scheduler.v1.ParticipantAvailability
+	17, // 61: scheduler.v1.AvailabilityService.ReplaceParticipantSlots:output_type -> scheduler.v1.AvailabilityUpdate
+	17, // 62: scheduler.v1.AvailabilityService.AddParticipantSlot:output_type -> scheduler.v1.AvailabilityUpdate
+	17, // 63: scheduler.v1.AvailabilityService.RemoveParticipantSlots:output_type -> scheduler.v1.AvailabilityUpdate
+	35, // 64: scheduler.v1.AvailabilityService.DeleteParticipant:output_type -> google.protobuf.Empty
+	26, // 65: scheduler.v1.AvailabilityService.ImportAvailability:output_type -> scheduler.v1.ImportAvailabilityResponse
+	30, // 66: scheduler.v1.RecommendationService.FindCommonSlots:output_type -> scheduler.v1.FindCommonSlotsResponse
+	49, // [49:67] is the sub-list for method output_type
+	31, // [31:49] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_scheduler_proto_init() }
+func file_scheduler_proto_init() {
+	if File_scheduler_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_scheduler_proto_rawDesc), len(file_scheduler_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   32,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_scheduler_proto_goTypes,
+		DependencyIndexes: file_scheduler_proto_depIdxs,
+		MessageInfos:      file_scheduler_proto_msgTypes,
+	}.Build()
+	File_scheduler_proto = out.File
+	file_scheduler_proto_goTypes = nil
+	file_scheduler_proto_depIdxs = nil
+}
@@ -0,0 +1,929 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: scheduler.proto
+
+// gRPC API for the event scheduler. The services mirror the /v1 REST
+// endpoints and share their logic, so both APIs see the same events.
+
+package schedulerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EventService_CreateEvent_FullMethodName     = "/scheduler.v1.EventService/CreateEvent"
+	EventService_GetEvent_FullMethodName        = "/scheduler.v1.EventService/GetEvent"
+	EventService_ListEvents_FullMethodName      = "/scheduler.v1.EventService/ListEvents"
+	EventService_UpdateEvent_FullMethodName     = "/scheduler.v1.EventService/UpdateEvent"
+	EventService_PatchEvent_FullMethodName      = "/scheduler.v1.EventService/PatchEvent"
+	EventService_DeleteEvent_FullMethodName     = "/scheduler.v1.EventService/DeleteEvent"
+	EventService_FinalizeEvent_FullMethodName   = "/scheduler.v1.EventService/FinalizeEvent"
+	EventService_ListEventSlots_FullMethodName  = "/scheduler.v1.EventService/ListEventSlots"
+	EventService_AddEventSlot_FullMethodName    = "/scheduler.v1.EventService/AddEventSlot"
+	EventService_DeleteEventSlot_FullMethodName = "/scheduler.v1.EventService/DeleteEventSlot"
+)
+
+// EventServiceClient is the client API for EventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Events and their candidate slots
+type EventServiceClient interface {
+	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error)
+	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// Every event, ordered by ID
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// Replaces every client-writable field, like PUT /v1/events/{id}
+	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// Applies a JSON merge patch, like PATCH /v1/events/{id}
+	PatchEvent(ctx context.Context, in *PatchEventRequest, opts ...grpc.CallOption) (*Event, error)
+	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	FinalizeEvent(ctx context.Context, in *FinalizeEventRequest, opts ...grpc.CallOption) (*Event, error)
+	ListEventSlots(ctx context.Context, in *ListEventSlotsRequest, opts ...grpc.CallOption) (*ListEventSlotsResponse, error)
+	AddEventSlot(ctx context.Context, in *AddEventSlotRequest, opts ...grpc.CallOption) (*Slot, error)
+	DeleteEventSlot(ctx context.Context, in *DeleteEventSlotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type eventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventServiceClient(cc grpc.ClientConnInterface) EventServiceClient {
+	return &eventServiceClient{cc}
+}
+
+func (c *eventServiceClient) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_CreateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_GetEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_ListEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_UpdateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) PatchEvent(ctx context.Context, in *PatchEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_PatchEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventService_DeleteEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) FinalizeEvent(ctx context.Context, in *FinalizeEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_FinalizeEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListEventSlots(ctx context.Context, in *ListEventSlotsRequest, opts ...grpc.CallOption) (*ListEventSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventSlotsResponse)
+	err := c.cc.Invoke(ctx, EventService_ListEventSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) AddEventSlot(ctx context.Context, in *AddEventSlotRequest, opts ...grpc.CallOption) (*Slot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Slot)
+	err := c.cc.Invoke(ctx, EventService_AddEventSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteEventSlot(ctx context.Context, in *DeleteEventSlotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventService_DeleteEventSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventServiceServer is the server API for EventService service.
+// All implementations must embed UnimplementedEventServiceServer
+// for forward compatibility.
+//
+// Events and their candidate slots
+type EventServiceServer interface {
+	CreateEvent(context.Context, *CreateEventRequest) (*Event, error)
+	GetEvent(context.Context, *GetEventRequest) (*Event, error)
+	// Every event, ordered by ID
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// Replaces every client-writable field, like PUT /v1/events/{id}
+	UpdateEvent(context.Context, *UpdateEventRequest) (*Event, error)
+	// Applies a JSON merge patch, like PATCH /v1/events/{id}
+	PatchEvent(context.Context, *PatchEventRequest) (*Event, error)
+	DeleteEvent(context.Context, *DeleteEventRequest) (*emptypb.Empty, error)
+	FinalizeEvent(context.Context, *FinalizeEventRequest) (*Event, error)
+	ListEventSlots(context.Context, *ListEventSlotsRequest) (*ListEventSlotsResponse, error)
+	AddEventSlot(context.Context, *AddEventSlotRequest) (*Slot, error)
+	DeleteEventSlot(context.Context, *DeleteEventSlotRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+// UnimplementedEventServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventServiceServer struct{}
+
+func (UnimplementedEventServiceServer) CreateEvent(context.Context, *CreateEventRequest) (*Event, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (UnimplementedEventServiceServer) GetEvent(context.Context, *GetEventRequest) (*Event, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEvent not implemented")
+}
+func (UnimplementedEventServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateEvent(context.Context, *UpdateEventRequest) (*Event, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateEvent not implemented")
+}
+func (UnimplementedEventServiceServer) PatchEvent(context.Context, *PatchEventRequest) (*Event, error) {
+	return nil, status.Error(codes.Unimplemented, "method PatchEvent not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteEvent(context.Context, *DeleteEventRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEvent not implemented")
+}
+func (UnimplementedEventServiceServer) FinalizeEvent(context.Context, *FinalizeEventRequest) (*Event, error) {
+	return nil, status.Error(codes.Unimplemented, "method FinalizeEvent not implemented")
+}
+func (UnimplementedEventServiceServer) ListEventSlots(context.Context, *ListEventSlotsRequest) (*ListEventSlotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEventSlots not implemented")
+}
+func (UnimplementedEventServiceServer) AddEventSlot(context.Context, *AddEventSlotRequest) (*Slot, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddEventSlot not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteEventSlot(context.Context, *DeleteEventSlotRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEventSlot not implemented")
+}
+func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
+func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventServiceServer will
+// result in compilation errors.
+type UnsafeEventServiceServer interface {
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
+	// If the following call panics, it indicates UnimplementedEventServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EventService_ServiceDesc, srv)
+}
+
+func _EventService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateEvent(ctx, req.(*CreateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEvent(ctx, req.(*GetEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateEvent(ctx, req.(*UpdateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_PatchEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).PatchEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_PatchEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).PatchEvent(ctx, req.(*PatchEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteEvent(ctx, req.(*DeleteEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_FinalizeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).FinalizeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_FinalizeEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).FinalizeEvent(ctx, req.(*FinalizeEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListEventSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEventSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListEventSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEventSlots(ctx, req.(*ListEventSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_AddEventSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AddEventSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AddEventSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AddEventSlot(ctx, req.(*AddEventSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteEventSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteEventSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteEventSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteEventSlot(ctx, req.(*DeleteEventSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scheduler.v1.EventService",
+	HandlerType: (*EventServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEvent",
+			Handler:    _EventService_CreateEvent_Handler,
+		},
+		{
+			MethodName: "GetEvent",
+			Handler:    _EventService_GetEvent_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _EventService_ListEvents_Handler,
+		},
+		{
+			MethodName: "UpdateEvent",
+			Handler:    _EventService_UpdateEvent_Handler,
+		},
+		{
+			MethodName: "PatchEvent",
+			Handler:    _EventService_PatchEvent_Handler,
+		},
+		{
+			MethodName: "DeleteEvent",
+			Handler:    _EventService_DeleteEvent_Handler,
+		},
+		{
+			MethodName: "FinalizeEvent",
+			Handler:    _EventService_FinalizeEvent_Handler,
+		},
+		{
+			MethodName: "ListEventSlots",
+			Handler:    _EventService_ListEventSlots_Handler,
+		},
+		{
+			MethodName: "AddEventSlot",
+			Handler:    _EventService_AddEventSlot_Handler,
+		},
+		{
+			MethodName: "DeleteEventSlot",
+			Handler:    _EventService_DeleteEventSlot_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "scheduler.proto",
+}
+
+const (
+	AvailabilityService_GetParticipant_FullMethodName          = "/scheduler.v1.AvailabilityService/GetParticipant"
+	AvailabilityService_ListParticipantSlots_FullMethodName    = "/scheduler.v1.AvailabilityService/ListParticipantSlots"
+	AvailabilityService_ReplaceParticipantSlots_FullMethodName = "/scheduler.v1.AvailabilityService/ReplaceParticipantSlots"
+	AvailabilityService_AddParticipantSlot_FullMethodName      = "/scheduler.v1.AvailabilityService/AddParticipantSlot"
+	AvailabilityService_RemoveParticipantSlots_FullMethodName  = "/scheduler.v1.AvailabilityService/RemoveParticipantSlots"
+	AvailabilityService_DeleteParticipant_FullMethodName       = "/scheduler.v1.AvailabilityService/DeleteParticipant"
+	AvailabilityService_ImportAvailability_FullMethodName      = "/scheduler.v1.AvailabilityService/ImportAvailability"
+)
+
+// AvailabilityServiceClient is the client API for AvailabilityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Participants' availability for events
+type AvailabilityServiceClient interface {
+	// Availability for every event the participant responded to
+	GetParticipant(ctx context.Context, in *GetParticipantRequest, opts ...grpc.CallOption) (*GetParticipantResponse, error)
+	ListParticipantSlots(ctx context.Context, in *ListParticipantSlotsRequest, opts ...grpc.CallOption) (*ParticipantAvailability, error)
+	ReplaceParticipantSlots(ctx context.Context, in *ReplaceParticipantSlotsRequest, opts ...grpc.CallOption) (*AvailabilityUpdate, error)
+	AddParticipantSlot(ctx context.Context, in *AddParticipantSlotRequest, opts ...grpc.CallOption) (*AvailabilityUpdate, error)
+	// Cuts the time between start_time and end_time out of the one-off slots
+	RemoveParticipantSlots(ctx context.Context, in *RemoveParticipantSlotsRequest, opts ...grpc.CallOption) (*AvailabilityUpdate, error)
+	// Removes the participant and their availability from the event
+	DeleteParticipant(ctx context.Context, in *DeleteParticipantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Bulk imports availability from CSV, like
+	// POST /v1/events/{id}/participants/import; nothing is written if any row
+	// is invalid
+	ImportAvailability(ctx context.Context, in *ImportAvailabilityRequest, opts ...grpc.CallOption) (*ImportAvailabilityResponse, error)
+}
+
+type availabilityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAvailabilityServiceClient(cc grpc.ClientConnInterface) AvailabilityServiceClient {
+	return &availabilityServiceClient{cc}
+}
+
+func (c *availabilityServiceClient) GetParticipant(ctx context.Context, in *GetParticipantRequest, opts ...grpc.CallOption) (*GetParticipantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetParticipantResponse)
+	err := c.cc.Invoke(ctx, AvailabilityService_GetParticipant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityServiceClient) ListParticipantSlots(ctx context.Context, in *ListParticipantSlotsRequest, opts ...grpc.CallOption) (*ParticipantAvailability, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParticipantAvailability)
+	err := c.cc.Invoke(ctx, AvailabilityService_ListParticipantSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityServiceClient) ReplaceParticipantSlots(ctx context.Context, in *ReplaceParticipantSlotsRequest, opts ...grpc.CallOption) (*AvailabilityUpdate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AvailabilityUpdate)
+	err := c.cc.Invoke(ctx, AvailabilityService_ReplaceParticipantSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityServiceClient) AddParticipantSlot(ctx context.Context, in *AddParticipantSlotRequest, opts ...grpc.CallOption) (*AvailabilityUpdate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AvailabilityUpdate)
+	err := c.cc.Invoke(ctx, AvailabilityService_AddParticipantSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityServiceClient) RemoveParticipantSlots(ctx context.Context, in *RemoveParticipantSlotsRequest, opts ...grpc.CallOption) (*AvailabilityUpdate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AvailabilityUpdate)
+	err := c.cc.Invoke(ctx, AvailabilityService_RemoveParticipantSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityServiceClient) DeleteParticipant(ctx context.Context, in *DeleteParticipantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AvailabilityService_DeleteParticipant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityServiceClient) ImportAvailability(ctx context.Context, in *ImportAvailabilityRequest, opts ...grpc.CallOption) (*ImportAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportAvailabilityResponse)
+	err := c.cc.Invoke(ctx, AvailabilityService_ImportAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AvailabilityServiceServer is the server API for AvailabilityService service.
+// All implementations must embed UnimplementedAvailabilityServiceServer
+// for forward compatibility.
+//
+// Participants' availability for events
+type AvailabilityServiceServer interface {
+	// Availability for every event the participant responded to
+	GetParticipant(context.Context, *GetParticipantRequest) (*GetParticipantResponse, error)
+	ListParticipantSlots(context.Context, *ListParticipantSlotsRequest) (*ParticipantAvailability, error)
+	ReplaceParticipantSlots(context.Context, *ReplaceParticipantSlotsRequest) (*AvailabilityUpdate, error)
+	AddParticipantSlot(context.Context, *AddParticipantSlotRequest) (*AvailabilityUpdate, error)
+	// Cuts the time between start_time and end_time out of the one-off slots
+	RemoveParticipantSlots(context.Context, *RemoveParticipantSlotsRequest) (*AvailabilityUpdate, error)
+	// Removes the participant and their availability from the event
+	DeleteParticipant(context.Context, *DeleteParticipantRequest) (*emptypb.Empty, error)
+	// Bulk imports availability from CSV, like
+	// POST /v1/events/{id}/participants/import; nothing is written if any row
+	// is invalid
+	ImportAvailability(context.Context, *ImportAvailabilityRequest) (*ImportAvailabilityResponse, error)
+	mustEmbedUnimplementedAvailabilityServiceServer()
+}
+
+// UnimplementedAvailabilityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAvailabilityServiceServer struct{}
+
+func (UnimplementedAvailabilityServiceServer) GetParticipant(context.Context, *GetParticipantRequest) (*GetParticipantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetParticipant not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) ListParticipantSlots(context.Context, *ListParticipantSlotsRequest) (*ParticipantAvailability, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListParticipantSlots not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) ReplaceParticipantSlots(context.Context, *ReplaceParticipantSlotsRequest) (*AvailabilityUpdate, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplaceParticipantSlots not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) AddParticipantSlot(context.Context, *AddParticipantSlotRequest) (*AvailabilityUpdate, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddParticipantSlot not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) RemoveParticipantSlots(context.Context, *RemoveParticipantSlotsRequest) (*AvailabilityUpdate, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveParticipantSlots not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) DeleteParticipant(context.Context, *DeleteParticipantRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteParticipant not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) ImportAvailability(context.Context, *ImportAvailabilityRequest) (*ImportAvailabilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportAvailability not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) mustEmbedUnimplementedAvailabilityServiceServer() {}
+func (UnimplementedAvailabilityServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeAvailabilityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AvailabilityServiceServer will
+// result in compilation errors.
+type UnsafeAvailabilityServiceServer interface {
+	mustEmbedUnimplementedAvailabilityServiceServer()
+}
+
+func RegisterAvailabilityServiceServer(s grpc.ServiceRegistrar, srv AvailabilityServiceServer) {
+	// If the following call panics, it indicates UnimplementedAvailabilityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AvailabilityService_ServiceDesc, srv)
+}
+
+func _AvailabilityService_GetParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetParticipantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).GetParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_GetParticipant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).GetParticipant(ctx, req.(*GetParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityService_ListParticipantSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListParticipantSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).ListParticipantSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_ListParticipantSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).ListParticipantSlots(ctx, req.(*ListParticipantSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityService_ReplaceParticipantSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceParticipantSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).ReplaceParticipantSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_ReplaceParticipantSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).ReplaceParticipantSlots(ctx, req.(*ReplaceParticipantSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityService_AddParticipantSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddParticipantSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).AddParticipantSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_AddParticipantSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).AddParticipantSlot(ctx, req.(*AddParticipantSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityService_RemoveParticipantSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveParticipantSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).RemoveParticipantSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_RemoveParticipantSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).RemoveParticipantSlots(ctx, req.(*RemoveParticipantSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityService_DeleteParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteParticipantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).DeleteParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_DeleteParticipant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).DeleteParticipant(ctx, req.(*DeleteParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityService_ImportAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).ImportAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_ImportAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).ImportAvailability(ctx, req.(*ImportAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AvailabilityService_ServiceDesc is the grpc.ServiceDesc for AvailabilityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AvailabilityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scheduler.v1.AvailabilityService",
+	HandlerType: (*AvailabilityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetParticipant",
+			Handler:    _AvailabilityService_GetParticipant_Handler,
+		},
+		{
+			MethodName: "ListParticipantSlots",
+			Handler:    _AvailabilityService_ListParticipantSlots_Handler,
+		},
+		{
+			MethodName: "ReplaceParticipantSlots",
+			Handler:    _AvailabilityService_ReplaceParticipantSlots_Handler,
+		},
+		{
+			MethodName: "AddParticipantSlot",
+			Handler:    _AvailabilityService_AddParticipantSlot_Handler,
+		},
+		{
+			MethodName: "RemoveParticipantSlots",
+			Handler:    _AvailabilityService_RemoveParticipantSlots_Handler,
+		},
+		{
+			MethodName: "DeleteParticipant",
+			Handler:    _AvailabilityService_DeleteParticipant_Handler,
+		},
+		{
+			MethodName: "ImportAvailability",
+			Handler:    _AvailabilityService_ImportAvailability_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "scheduler.proto",
+}
+
+const (
+	RecommendationService_FindCommonSlots_FullMethodName = "/scheduler.v1.RecommendationService/FindCommonSlots"
+)
+
+// RecommendationServiceClient is the client API for RecommendationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Slot recommendation
+type RecommendationServiceClient interface {
+	FindCommonSlots(ctx context.Context, in *FindCommonSlotsRequest, opts ...grpc.CallOption) (*FindCommonSlotsResponse, error)
+}
+
+type recommendationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecommendationServiceClient(cc grpc.ClientConnInterface) RecommendationServiceClient {
+	return &recommendationServiceClient{cc}
+}
+
+func (c *recommendationServiceClient) FindCommonSlots(ctx context.Context, in *FindCommonSlotsRequest, opts ...grpc.CallOption) (*FindCommonSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindCommonSlotsResponse)
+	err := c.cc.Invoke(ctx, RecommendationService_FindCommonSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecommendationServiceServer is the server API for RecommendationService service.
+// All implementations must embed UnimplementedRecommendationServiceServer
+// for forward compatibility.
+//
+// Slot recommendation
+type RecommendationServiceServer interface {
+	FindCommonSlots(context.Context, *FindCommonSlotsRequest) (*FindCommonSlotsResponse, error)
+	mustEmbedUnimplementedRecommendationServiceServer()
+}
+
+// UnimplementedRecommendationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecommendationServiceServer struct{}
+
+func (UnimplementedRecommendationServiceServer) FindCommonSlots(context.Context, *FindCommonSlotsRequest) (*FindCommonSlotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindCommonSlots not implemented")
+}
+func (UnimplementedRecommendationServiceServer) mustEmbedUnimplementedRecommendationServiceServer() {}
+func (UnimplementedRecommendationServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeRecommendationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecommendationServiceServer will
+// result in compilation errors.
+type UnsafeRecommendationServiceServer interface {
+	mustEmbedUnimplementedRecommendationServiceServer()
+}
+
+func RegisterRecommendationServiceServer(s grpc.ServiceRegistrar, srv RecommendationServiceServer) {
+	// If the following call panics, it indicates UnimplementedRecommendationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecommendationService_ServiceDesc, srv)
+}
+
+func _RecommendationService_FindCommonSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindCommonSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecommendationServiceServer).FindCommonSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecommendationService_FindCommonSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecommendationServiceServer).FindCommonSlots(ctx, req.(*FindCommonSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecommendationService_ServiceDesc is the grpc.ServiceDesc for RecommendationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecommendationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scheduler.v1.RecommendationService",
+	HandlerType: (*RecommendationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindCommonSlots",
+			Handler:    _RecommendationService_FindCommonSlots_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "scheduler.proto",
+}
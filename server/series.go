@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// SeriesOptions turns an event into a recurring meeting: each candidate slot
+// is the first of Occurrences meetings held every IntervalWeeks weeks
+type SeriesOptions struct {
+	IntervalWeeks int    `json:"interval_weeks,omitempty"`
+	Occurrences   int    `json:"occurrences"`
+	Zone          string `json:"zone,omitempty"`
+}
+
+// SeriesRecommendation scores a candidate weekly time across the whole series
+type SeriesRecommendation struct {
+	Slot        Slot              `json:"slot"`
+	Score       int               `json:"score"`
+	Attendance  map[string]int    `json:"attendance"`
+	Occurrences []SlotUnavailable `json:"occurrences"`
+}
+
+// maxSeriesOccurrences bounds the length of a series, ten years of weekly
+// meetings. Every recommendation checks the whole series for each candidate.
+const maxSeriesOccurrences = 520
+
+// Check the series options describe a weekly or biweekly series
+func validateSeries(series *SeriesOptions) error {
+	if series == nil {
+		return nil
+	}
+	if series.IntervalWeeks != 0 && series.IntervalWeeks != 1 && series.IntervalWeeks != 2 {
+		return fmt.Errorf("interval_weeks must be 1 or 2")
+	}
+	if series.Occurrences < 1 {
+		return fmt.Errorf("occurrences must be at least 1")
+	}
+	if series.Occurrences > maxSeriesOccurrences {
+		return fmt.Errorf("occurrences must be at most %d", maxSeriesOccurrences)
+	}
+	if _, err := time.LoadLocation(series.Zone); err != nil {
+		return fmt.Errorf("unknown zone %q", series.Zone)
+	}
+	return nil
+}
+
+// Get every occurrence of a series starting at the candidate slot. Occurrences
+// keep the same wall clock time in the series' zone across DST changes.
+func seriesOccurrences(slot Slot, series SeriesOptions) []Slot {
+	interval := series.IntervalWeeks
+	if interval == 0 {
+		interval = 1
+	}
+	loc, err := time.LoadLocation(series.Zone)
+	if err != nil {
+		loc = slot.StartTime.Location()
+	}
+	start := slot.StartTime.In(loc)
+	length := slot.EndTime.Sub(slot.StartTime)
+	occurrences := make([]Slot, 0, series.Occurrences)
+	for i := 0; i < series.Occurrences; i++ {
+		occurrenceStart := start.AddDate(0, 0, 7*interval*i)
+		occurrences = append(occurrences, Slot{StartTime: occurrenceStart, EndTime: occurrenceStart.Add(length)})
+	}
+	return occurrences
+}
+
+// Score every candidate slot by how many occurrences each participant can
+// attend, best first. availability is the participants' expanded availability
+// from eventAvailability.
+func recommendSeries(event Event, availability map[string]ParticipantAvailability) []SeriesRecommendation {
+	var recommendations []SeriesRecommendation
+	for _, candidate := range eventSlots(event) {
+		recommendation := SeriesRecommendation{
+			Slot:       candidate,
+			Attendance: map[string]int{},
+		}
+		for _, occurrence := range seriesOccurrences(candidate, *event.Series) {
+			unavailable := []string{}
+			for _, participantID := range event.Participants {
+				if attendsSlot(occurrence, event.EstimatedTime, availability[participantID]) {
+					recommendation.Attendance[participantID]++
+					recommendation.Score++
+				} else {
+					unavailable = append(unavailable, participantID)
+				}
+			}
+			recommendation.Occurrences = append(recommendation.Occurrences, SlotUnavailable{
+				Slot:                    occurrence,
+				UnavailableParticipants: unavailable,
+			})
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].Score > recommendations[j].Score
+	})
+	return recommendations
+}
+
+// Summarize a series as the top-scoring candidate slots, each listing the
+// participants who miss at least one occurrence
+func recommendSeriesSlots(event Event, series []SeriesRecommendation) []SlotUnavailable {
+	var recommended []SlotUnavailable
+	for _, recommendation := range series {
+		if recommendation.Score < series[0].Score {
+			break
+		}
+		unavailable := []string{}
+		for _, participantID := range event.Participants {
+			if recommendation.Attendance[participantID] < event.Series.Occurrences {
+				unavailable = append(unavailable, participantID)
+			}
+		}
+		recommended = append(recommended, SlotUnavailable{
+			Slot:                    recommendation.Slot,
+			UnavailableParticipants: unavailable,
+		})
+	}
+	return recommended
+}
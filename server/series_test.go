@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindCommonSlotsSeries(t *testing.T) {
+	router := setupRouter()
+
+	monday := Slot{StartTime: time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 13, 15, 0, 0, 0, time.UTC)}
+	tuesday := Slot{StartTime: monday.StartTime.AddDate(0, 0, 1), EndTime: monday.EndTime.AddDate(0, 0, 1)}
+	week := func(slot Slot, n int) Slot {
+		return Slot{StartTime: slot.StartTime.AddDate(0, 0, 7*n), EndTime: slot.EndTime.AddDate(0, 0, 7*n)}
+	}
+	events["series"] = Event{
+		ID:            "series",
+		Title:         "Weekly sync",
+		Slots:         []Slot{monday, tuesday},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"s1", "s2"},
+		Series:        &SeriesOptions{IntervalWeeks: 1, Occurrences: 3},
+	}
+	// s1 can make every Monday but only the first Tuesday
+	participants["s1"] = []Participant{{ID: "s1", EventID: "series", Availability: []Slot{
+		monday, week(monday, 1), week(monday, 2), tuesday,
+	}}}
+	// s2 can make every Tuesday but misses the second Monday
+	participants["s2"] = []Participant{{ID: "s2", EventID: "series", Availability: []Slot{
+		monday, week(monday, 2), tuesday, week(tuesday, 1), week(tuesday, 2),
+	}}}
+
+	req, err := http.NewRequest("GET", "/event/series/find-common-slots", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	var response AvailabilityResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+
+	// Monday wins with 5 of 6 attendances
+	if assert.Len(t, response.RecommendedTimeSlots, 1) {
+		assert.True(t, response.RecommendedTimeSlots[0].Slot.StartTime.Equal(monday.StartTime))
+		assert.Equal(t, []string{"s2"}, response.RecommendedTimeSlots[0].UnavailableParticipants)
+	}
+	if assert.Len(t, response.SeriesRecommendations, 2) {
+		best := response.SeriesRecommendations[0]
+		assert.Equal(t, 5, best.Score)
+		assert.Equal(t, map[string]int{"s1": 3, "s2": 2}, best.Attendance)
+		if assert.Len(t, best.Occurrences, 3) {
+			assert.Empty(t, best.Occurrences[0].UnavailableParticipants)
+			assert.Equal(t, []string{"s2"}, best.Occurrences[1].UnavailableParticipants)
+		}
+		assert.Equal(t, 4, response.SeriesRecommendations[1].Score)
+	}
+}
+
+func TestFindCommonSlotsSeriesRecurringAvailability(t *testing.T) {
+	monday := Slot{StartTime: time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 13, 15, 0, 0, 0, time.UTC)}
+	events["series-recurring"] = Event{
+		ID:            "series-recurring",
+		Title:         "Weekly sync",
+		Slots:         []Slot{monday},
+		EstimatedTime: 1 * time.Hour,
+		Participants:  []string{"sr1", "sr2"},
+		Series:        &SeriesOptions{IntervalWeeks: 1, Occurrences: 4},
+	}
+	// sr1 is free every Monday afternoon, sr2 only for the first three
+	participants["sr1"] = []Participant{{ID: "sr1", EventID: "series-recurring", Availability: []Slot{
+		{StartTime: monday.StartTime.Add(-time.Hour), EndTime: monday.EndTime.Add(time.Hour), RRule: "FREQ=WEEKLY"},
+	}}}
+	participants["sr2"] = []Participant{{ID: "sr2", EventID: "series-recurring", Availability: []Slot{
+		{StartTime: monday.StartTime, EndTime: monday.EndTime, RRule: "FREQ=WEEKLY;COUNT=3"},
+	}}}
+
+	req, err := http.NewRequest("GET", "/event/series-recurring/find-common-slots", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	setupRouter().ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	var response AvailabilityResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+
+	// Recurring availability counts for every week of the series
+	if assert.Len(t, response.SeriesRecommendations, 1) {
+		best := response.SeriesRecommendations[0]
+		assert.Equal(t, map[string]int{"sr1": 4, "sr2": 3}, best.Attendance)
+		if assert.Len(t, best.Occurrences, 4) {
+			assert.Empty(t, best.Occurrences[2].UnavailableParticipants)
+			assert.Equal(t, []string{"sr2"}, best.Occurrences[3].UnavailableParticipants)
+		}
+	}
+}
+
+func TestCreateEventSeriesTooLong(t *testing.T) {
+	body := `{
+		"title": "Weekly sync",
+		"slots": [{"start_time": "2025-01-13T14:00:00Z", "end_time": "2025-01-13T15:00:00Z"}],
+		"estimatedTime": 3600000000000,
+		"series": {"interval_weeks": 1, "occurrences": 1000000000}
+	}`
+	req, err := http.NewRequest("POST", "/v1/events", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	setupRouter().ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, "Expected status code 422")
+	var problem Problem
+	if err := json.NewDecoder(rr.Body).Decode(&problem); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	assert.Equal(t, []FieldError{
+		{Field: "series", Message: "occurrences must be at most 520"},
+	}, problem.Errors)
+}
+
+func TestFindCommonSlotsLongSeriesIsBounded(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	created, err := schedule.CreateEvent(Event{
+		Title:         "Daily standup for ten years",
+		Slots:         []Slot{{StartTime: start, EndTime: start.Add(time.Hour), RRule: "FREQ=DAILY"}},
+		EstimatedTime: 30 * time.Minute,
+		Participants:  []string{"l1", "l2", "l3"},
+		Series:        &SeriesOptions{IntervalWeeks: 1, Occurrences: maxSeriesOccurrences},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Each participant's availability is expanded once per recommendation
+	// pass, not once per candidate, occurrence and participant, so the
+	// longest series the API accepts stays well within a request's time
+	began := time.Now()
+	for _, participantID := range []string{"l1", "l2", "l3"} {
+		delete(participants, participantID)
+		_, err := schedule.ReplaceParticipantSlots(created.ID, participantID, []Slot{{StartTime: start, EndTime: start.Add(2 * time.Hour), RRule: "FREQ=DAILY"}})
+		assert.NoError(t, err)
+	}
+	response, err := schedule.FindCommonSlots(created.ID)
+	elapsed := time.Since(began)
+	assert.NoError(t, err)
+	assert.Less(t, elapsed, 5*time.Second)
+
+	// Every candidate day works for everyone across the whole series
+	if assert.Len(t, response.SeriesRecommendations, 90) {
+		assert.Equal(t, 3*maxSeriesOccurrences, response.SeriesRecommendations[0].Score)
+	}
+	assert.Len(t, response.RecommendedTimeSlots, 90)
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"sort"
+	"time"
+
+	"github.com/deepakg86/go-event-scheduler/api"
+)
+
+// AvailabilityNormalization reports how submitted availability was changed
+// before it was stored. It is generated from openapi.yaml.
+type AvailabilityNormalization = api.AvailabilityNormalization
+
+// Sort slots by start time, then end time
+func sortSlots(slots []Slot) {
+	sort.Slice(slots, func(i, j int) bool {
+		if slots[i].StartTime.Equal(slots[j].StartTime) {
+			return slots[i].EndTime.Before(slots[j].EndTime)
+		}
+		return slots[i].StartTime.Before(slots[j].StartTime)
+	})
+}
+
+// Merge overlapping and adjacent slots into a sorted list of disjoint slots
+func mergeSlots(slots []Slot) []Slot {
+	if len(slots) == 0 {
+		return nil
+	}
+	sorted := append([]Slot(nil), slots...)
+	sortSlots(sorted)
+	merged := []Slot{sorted[0]}
+	for _, slot := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if slot.StartTime.After(last.EndTime) {
+			merged = append(merged, slot)
+			continue
+		}
+		if slot.EndTime.After(last.EndTime) {
+			last.EndTime = slot.EndTime
+		}
+	}
+	return merged
+}
+
+// Remove the busy time from the free slots, returning what is left
+func subtractSlots(free []Slot, busy []Slot) []Slot {
+	busy = mergeSlots(busy)
+	var remaining []Slot
+	for _, slot := range mergeSlots(free) {
+		start := slot.StartTime
+		for _, b := range busy {
+			if !b.EndTime.After(start) || !b.StartTime.Before(slot.EndTime) {
+				continue
+			}
+			if b.StartTime.After(start) {
+				remaining = append(remaining, Slot{StartTime: start, EndTime: b.StartTime})
+			}
+			start = b.EndTime
+		}
+		if start.Before(slot.EndTime) {
+			remaining = append(remaining, Slot{StartTime: start, EndTime: slot.EndTime})
+		}
+	}
+	return remaining
+}
+
+// Normalize availability for an event: one-off slots with the same preference
+// are merged when they overlap or touch, clipped to the event's slots and
+// sorted. Recurring slots are kept as they are. Slots are not clipped for an
+// event without slots.
+func normalizeAvailability(slots []Slot, event Event) ([]Slot, AvailabilityNormalization) {
+	var normalization AvailabilityNormalization
+	var normalized []Slot
+	byPreference := map[string][]Slot{}
+	var preferences []string
+	for _, slot := range slots {
+		if slot.RRule != "" {
+			normalized = append(normalized, slot)
+			continue
+		}
+		if _, seen := byPreference[slot.Preference]; !seen {
+			preferences = append(preferences, slot.Preference)
+		}
+		byPreference[slot.Preference] = append(byPreference[slot.Preference], slot)
+	}
+
+	bounds := mergeSlots(eventSlots(event))
+	for _, preference := range preferences {
+		group := byPreference[preference]
+		merged := mergeSlots(group)
+		normalization.Merged += len(group) - len(merged)
+		for _, slot := range merged {
+			if len(bounds) == 0 {
+				normalized = append(normalized, slot)
+				continue
+			}
+			pieces := clipSlot(slot, bounds)
+			if len(pieces) == 0 {
+				normalization.Dropped++
+				continue
+			}
+			if len(pieces) > 1 || !pieces[0].StartTime.Equal(slot.StartTime) || !pieces[0].EndTime.Equal(slot.EndTime) {
+				normalization.Clipped++
+			}
+			normalized = append(normalized, pieces...)
+		}
+	}
+	sortSlots(normalized)
+	// Availability points at the event slots it covers rather than having IDs of its own
+	for i := range normalized {
+		normalized[i].ID = ""
+	}
+	tagSlotIDs(normalized, event)
+	if normalized == nil {
+		normalized = []Slot{}
+	}
+	return normalized, normalization
+}
+
+// Cut a slot down to the parts inside the merged bounds
+func clipSlot(slot Slot, bounds []Slot) []Slot {
+	var pieces []Slot
+	for _, b := range bounds {
+		start := latest(slot.StartTime, b.StartTime)
+		end := earliest(slot.EndTime, b.EndTime)
+		if start.Before(end) {
+			piece := slot
+			piece.StartTime, piece.EndTime = start, end
+			pieces = append(pieces, piece)
+		}
+	}
+	return pieces
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
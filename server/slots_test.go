@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeAvailability(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	event := Event{
+		ID:    "norm",
+		Title: "Test Event",
+		Slots: []Slot{
+			{StartTime: start, EndTime: start.Add(2 * time.Hour)},
+			{StartTime: start.Add(24 * time.Hour), EndTime: start.Add(26 * time.Hour)},
+		},
+		EstimatedTime: 1 * time.Hour,
+	}
+	slots, normalization := normalizeAvailability([]Slot{
+		// 3:30-5:00 is clipped to 3:30-4:00
+		{StartTime: start.Add(90 * time.Minute), EndTime: start.Add(3 * time.Hour)},
+		// 2:00-3:00 and 2:30-3:00 merge
+		{StartTime: start, EndTime: start.Add(time.Hour)},
+		{StartTime: start.Add(30 * time.Minute), EndTime: start.Add(time.Hour)},
+		// Outside every event slot
+		{StartTime: start.Add(5 * time.Hour), EndTime: start.Add(6 * time.Hour)},
+		// A different preference is kept apart
+		{StartTime: start.Add(24 * time.Hour), EndTime: start.Add(25 * time.Hour), Preference: "if_needed"},
+	}, event)
+
+	assert.Equal(t, []Slot{
+		{StartTime: start, EndTime: start.Add(time.Hour)},
+		{StartTime: start.Add(90 * time.Minute), EndTime: start.Add(2 * time.Hour)},
+		{StartTime: start.Add(24 * time.Hour), EndTime: start.Add(25 * time.Hour), Preference: "if_needed"},
+	}, slots)
+	assert.Equal(t, AvailabilityNormalization{Merged: 1, Clipped: 1, Dropped: 1}, normalization)
+}
+
+func TestCreateAvailabilityNormalized(t *testing.T) {
+	router := setupRouter()
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["normapi"] = Event{
+		ID:            "normapi",
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: start, EndTime: start.Add(3 * time.Hour)}},
+		EstimatedTime: 1 * time.Hour,
+	}
+	// 2-3PM and 2:30-4PM become 2-4PM
+	body, _ := json.Marshal(map[string]interface{}{
+		"participant_id": "n1",
+		"event_id":       "normapi",
+		"slots": []Slot{
+			{StartTime: start, EndTime: start.Add(time.Hour)},
+			{StartTime: start.Add(30 * time.Minute), EndTime: start.Add(2 * time.Hour)},
+		},
+	})
+	req, err := http.NewRequest("POST", "/participant", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code, "Expected status code 200")
+	var response struct {
+		Availability []Slot                    `json:"availability"`
+		Normalized   AvailabilityNormalization `json:"normalized"`
+	}
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	assert.Equal(t, AvailabilityNormalization{Merged: 1}, response.Normalized)
+	assert.Len(t, response.Availability, 1)
+	assert.Equal(t, []Slot{{StartTime: start, EndTime: start.Add(2 * time.Hour)}}, participants["n1"][0].Availability)
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// eventUpdate is a change to an event, sent to its streams as a server-sent
+// event named after Type
+type eventUpdate struct {
+	EventID string          `json:"event_id"`
+	Type    string          `json:"type"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// Stream message types
+const (
+	streamEventChanged    = "event"
+	streamAvailability    = "availability"
+	streamRecommendations = "recommendations"
+	streamDeleted         = "deleted"
+)
+
+// Broker delivers event updates to the streams subscribed to the event, on
+// this replica and any other
+type Broker interface {
+	Publish(update eventUpdate)
+	// Subscribe returns the event's updates and a function to stop them. The
+	// channel is closed if the subscriber falls too far behind.
+	Subscribe(eventID string) (<-chan eventUpdate, func())
+	// Snapshot returns the latest event, availability and recommendations
+	// updates published for an event, for streams of an event this replica
+	// does not hold. It reports false if the broker does not know the event.
+	Snapshot(eventID string) ([]eventUpdate, bool)
+	// Watched reports whether updates to the event may be read, so changes
+	// nobody will see do not pay for recomputing recommendations
+	Watched(eventID string) bool
+}
+
+// broker is used to publish every change to an event. main replaces it with a
+// Redis broker when REDIS_ADDR is set so the replicas share their updates.
+var broker Broker = newLocalBroker()
+
+// How many updates a stream can fall behind before it is dropped
+const streamBuffer = 64
+
+// localBroker delivers updates to the streams of this process
+type localBroker struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan eventUpdate]struct{}
+}
+
+func newLocalBroker() *localBroker {
+	return &localBroker{subscribers: map[string]map[chan eventUpdate]struct{}{}}
+}
+
+func (b *localBroker) Publish(update eventUpdate) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers[update.EventID] {
+		select {
+		case ch <- update:
+		default:
+			// Drop a stream that stopped reading; the client reconnects and
+			// starts again from a fresh snapshot
+			delete(b.subscribers[update.EventID], ch)
+			close(ch)
+		}
+	}
+}
+
+func (b *localBroker) Subscribe(eventID string) (<-chan eventUpdate, func()) {
+	ch := make(chan eventUpdate, streamBuffer)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.subscribers[eventID] == nil {
+		b.subscribers[eventID] = map[chan eventUpdate]struct{}{}
+	}
+	b.subscribers[eventID][ch] = struct{}{}
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, subscribed := b.subscribers[eventID][ch]; subscribed {
+			delete(b.subscribers[eventID], ch)
+			close(ch)
+		}
+		if len(b.subscribers[eventID]) == 0 {
+			delete(b.subscribers, eventID)
+		}
+	}
+}
+
+// The local broker only serves events held by this replica, which streams
+// take their snapshot from directly
+func (b *localBroker) Snapshot(eventID string) ([]eventUpdate, bool) {
+	return nil, false
+}
+
+// The local broker's updates are only read by this replica's streams
+func (b *localBroker) Watched(eventID string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers[eventID]) > 0
+}
+
+// Build an update with the data encoded as JSON
+func newEventUpdate(eventID string, updateType string, data interface{}) eventUpdate {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		log.Printf("Event %s: could not encode %s update: %v", eventID, updateType, err)
+	}
+	return eventUpdate{EventID: eventID, Type: updateType, Data: encoded}
+}
+
+// A participant's availability for an event as sent to its streams
+type availabilityMessage struct {
+	ParticipantID string `json:"participant_id"`
+	Responded     bool   `json:"responded"`
+	Availability  []Slot `json:"availability"`
+}
+
+// Build the availability update of a participant. The caller must hold mu.
+func availabilityUpdateMessage(eventID string, participant eventParticipant) eventUpdate {
+	availability := participant.Availability
+	if availability == nil {
+		availability = []Slot{}
+	}
+	return newEventUpdate(eventID, streamAvailability, availabilityMessage{
+		ParticipantID: participant.ID,
+		Responded:     participant.Responded,
+		Availability:  availability,
+	})
+}
+
+// Build the recommendations update of an event. The caller must hold mu.
+func recommendationsUpdate(eventID string, event Event) eventUpdate {
+	return newEventUpdate(eventID, streamRecommendations, commonSlots(eventID, event))
+}
+
+// Publish an event after it changed, with its recomputed recommendations if
+// anyone is watching it. The caller must hold mu.
+func publishEvent(eventID string) {
+	event, exists := events[eventID]
+	if !exists {
+		return
+	}
+	broker.Publish(newEventUpdate(eventID, streamEventChanged, event))
+	if broker.Watched(eventID) {
+		broker.Publish(recommendationsUpdate(eventID, event))
+	}
+	resetLiveSession(eventID)
+}
+
+// Publish a participant's availability for an event after it changed, with
+// the event's recomputed recommendations if anyone is watching it. The caller
+// must hold mu.
+func publishAvailability(eventID string, participantID string) {
+	publishAvailabilityChange(eventID, liveChange{ParticipantID: participantID})
+}
+
+// Publish a change to a participant's availability, recording which cells a
+// live session painted. The caller must hold mu.
+func publishAvailabilityChange(eventID string, change liveChange) {
+	event, exists := events[eventID]
+	if !exists {
+		return
+	}
+	participant, found := findParticipant(change.ParticipantID, eventID)
+	broker.Publish(availabilityUpdateMessage(eventID, eventParticipant{Participant: participant, Responded: found}))
+	if broker.Watched(eventID) {
+		broker.Publish(recommendationsUpdate(eventID, event))
+	}
+	recordLiveChange(eventID, change)
+}
+
+// Publish the deletion of an event. The caller must hold mu.
+func publishDeleted(eventID string) {
+	broker.Publish(newEventUpdate(eventID, streamDeleted, map[string]string{"event_id": eventID}))
+	closeLiveSession(eventID)
+}
+
+// How often an idle stream sends a comment so proxies keep it open
+var streamHeartbeat = 15 * time.Second
+
+// Stream Event Handler
+func streamEvent(w http.ResponseWriter, r *http.Request, eventID string) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeProblem(w, problemInternal, "Streaming is not supported")
+		return
+	}
+
+	// Subscribe before taking the snapshot so no change is missed in between
+	updates, unsubscribe := broker.Subscribe(eventID)
+	defer unsubscribe()
+	mu.Lock()
+	event, exists := events[eventID]
+	var snapshot []eventUpdate
+	if exists {
+		snapshot = append(snapshot, newEventUpdate(eventID, streamEventChanged, event))
+		for _, participant := range eventParticipants(eventID, event) {
+			snapshot = append(snapshot, availabilityUpdateMessage(eventID, participant))
+		}
+		snapshot = append(snapshot, recommendationsUpdate(eventID, event))
+	}
+	mu.Unlock()
+	// Events held by another replica are streamed from the broker's state
+	if !exists {
+		snapshot, exists = broker.Snapshot(eventID)
+	}
+	// If the event does not exist, return a 404 error
+	if !exists {
+		writeProblem(w, problemEventNotFound, "")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Stop nginx from buffering the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "retry: 3000\n\n")
+	for _, update := range snapshot {
+		writeServerSentEvent(w, update)
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+		case update, ok := <-updates:
+			if !ok {
+				return
+			}
+			writeServerSentEvent(w, update)
+			if update.Type == streamDeleted {
+				flusher.Flush()
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// Write an update in the text/event-stream format
+func writeServerSentEvent(w http.ResponseWriter, update eventUpdate) {
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", update.Type, update.Data)
+}
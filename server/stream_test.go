@@ -0,0 +1,244 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Read the next server-sent event from a stream, skipping comments
+func readServerSentEvent(t *testing.T, reader *bufio.Reader) (string, string) {
+	var name, data string
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "" && name != "":
+			return name, data
+		case strings.HasPrefix(line, "event: "):
+			name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func TestStreamEvent(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["stream"] = Event{
+		ID:            "stream",
+		Title:         "Streamed meeting",
+		Slots:         []Slot{{ID: "1", StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime: time.Hour,
+		Participants:  []string{"s1"},
+		lastSlotID:    1,
+	}
+	delete(participants, "s1")
+
+	server := httptest.NewServer(setupRouter())
+	defer server.Close()
+	resp, err := http.Get(server.URL + "/v1/events/stream/stream")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	reader := bufio.NewReader(resp.Body)
+
+	// The stream starts with the current state
+	name, data := readServerSentEvent(t, reader)
+	assert.Equal(t, "event", name)
+	assert.Contains(t, data, `"title":"Streamed meeting"`)
+	name, data = readServerSentEvent(t, reader)
+	assert.Equal(t, "availability", name)
+	assert.JSONEq(t, `{"participant_id":"s1","responded":false,"availability":[]}`, data)
+	name, data = readServerSentEvent(t, reader)
+	assert.Equal(t, "recommendations", name)
+	assert.JSONEq(t, `{"recommendedTimeSlots":[{"slot":{"id":"1","start_time":"2025-01-13T14:00:00Z","end_time":"2025-01-13T15:00:00Z"},"unavailableParticipants":["s1"]}]}`, data)
+
+	// Availability changes come with the recomputed recommendations
+	_, err = schedule.ReplaceParticipantSlots("stream", "s1", []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}})
+	require.NoError(t, err)
+	name, data = readServerSentEvent(t, reader)
+	assert.Equal(t, "availability", name)
+	var availability availabilityMessage
+	require.NoError(t, json.Unmarshal([]byte(data), &availability))
+	assert.True(t, availability.Responded)
+	assert.Len(t, availability.Availability, 1)
+	name, data = readServerSentEvent(t, reader)
+	assert.Equal(t, "recommendations", name)
+	assert.Contains(t, data, `"unavailableParticipants":[]`)
+
+	// So do edits to the event
+	_, err = schedule.AddEventSlot("stream", Slot{StartTime: start.Add(24 * time.Hour), EndTime: start.Add(25 * time.Hour)})
+	require.NoError(t, err)
+	name, data = readServerSentEvent(t, reader)
+	assert.Equal(t, "event", name)
+	assert.Contains(t, data, `"id":"2"`)
+	name, _ = readServerSentEvent(t, reader)
+	assert.Equal(t, "recommendations", name)
+
+	// Deleting the event ends the stream
+	require.NoError(t, schedule.DeleteEvent("stream"))
+	name, data = readServerSentEvent(t, reader)
+	assert.Equal(t, "deleted", name)
+	assert.JSONEq(t, `{"event_id":"stream"}`, data)
+	_, err = reader.ReadString('\n')
+	assert.Error(t, err, "Expected the stream to end")
+}
+
+func TestStreamEventNotFound(t *testing.T) {
+	req, err := http.NewRequest("GET", "/event/missing/stream", nil)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	setupRouter().ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusNotFound, rr.Code, "Expected status code 404")
+	assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "</v1/events/missing/stream>; rel=\"successor-version\"", rr.Header().Get("Link"))
+}
+
+func TestLocalBrokerDropsSlowStreams(t *testing.T) {
+	b := newLocalBroker()
+	updates, unsubscribe := b.Subscribe("slow")
+	defer unsubscribe()
+	for i := 0; i <= streamBuffer; i++ {
+		b.Publish(eventUpdate{EventID: "slow", Type: streamEventChanged})
+	}
+	received := 0
+	for range updates {
+		received++
+	}
+	assert.Equal(t, streamBuffer, received, "Expected the stream to be closed once its buffer was full")
+}
+
+// recordingBroker remembers the type of every update published through it
+type recordingBroker struct {
+	*localBroker
+	published []string
+}
+
+func (b *recordingBroker) Publish(update eventUpdate) {
+	b.published = append(b.published, update.Type)
+	b.localBroker.Publish(update)
+}
+
+func TestPublishSkipsRecommendationsNobodyWatches(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["unwatched"] = Event{
+		ID:            "unwatched",
+		Title:         "Unwatched meeting",
+		Slots:         []Slot{{ID: "1", StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime: time.Hour,
+		Participants:  []string{"u1"},
+		lastSlotID:    1,
+	}
+	delete(participants, "u1")
+	recording := &recordingBroker{localBroker: newLocalBroker()}
+	previous := broker
+	broker = recording
+	defer func() { broker = previous }()
+
+	// Without a stream, writes do not recompute the recommendations
+	_, err := schedule.ReplaceParticipantSlots("unwatched", "u1", []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}})
+	require.NoError(t, err)
+	_, err = schedule.AddEventSlot("unwatched", Slot{StartTime: start.Add(24 * time.Hour), EndTime: start.Add(25 * time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{streamAvailability, streamEventChanged}, recording.published)
+
+	// Once a stream subscribes, they are published with every change
+	recording.published = nil
+	_, unsubscribe := recording.Subscribe("unwatched")
+	defer unsubscribe()
+	_, err = schedule.ReplaceParticipantSlots("unwatched", "u1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{streamAvailability, streamRecommendations}, recording.published)
+}
+
+func TestRedisBrokerSharesUpdatesBetweenReplicas(t *testing.T) {
+	redisServer := miniredis.RunT(t)
+	replica := func() *redisBroker {
+		client := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+		t.Cleanup(func() { client.Close() })
+		return newRedisBroker(client)
+	}
+	first, second := replica(), replica()
+
+	updates, unsubscribe := second.Subscribe("shared")
+	defer unsubscribe()
+	first.Publish(newEventUpdate("shared", streamDeleted, map[string]string{"event_id": "shared"}))
+	first.Publish(newEventUpdate("other", streamDeleted, map[string]string{"event_id": "other"}))
+	select {
+	case update := <-updates:
+		assert.Equal(t, "shared", update.EventID)
+		assert.Equal(t, streamDeleted, update.Type)
+		assert.JSONEq(t, `{"event_id":"shared"}`, string(update.Data))
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the update published on the other replica")
+	}
+}
+
+func TestStreamEventFromAnotherReplica(t *testing.T) {
+	redisServer := miniredis.RunT(t)
+	replica := func() *redisBroker {
+		client := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+		t.Cleanup(func() { client.Close() })
+		return newRedisBroker(client)
+	}
+	first, second := replica(), replica()
+	previous := broker
+	broker = second
+	defer func() { broker = previous }()
+
+	// The event is held by the first replica only
+	delete(events, "remote")
+	first.Publish(newEventUpdate("remote", streamEventChanged, Event{ID: "remote", Title: "Remote meeting", Participants: []string{"r1", "r2"}}))
+	first.Publish(newEventUpdate("remote", streamAvailability, availabilityMessage{ParticipantID: "r3", Responded: true, Availability: []Slot{}}))
+	first.Publish(newEventUpdate("remote", streamAvailability, availabilityMessage{ParticipantID: "r1", Responded: true, Availability: []Slot{}}))
+	first.Publish(newEventUpdate("remote", streamRecommendations, map[string]interface{}{"recommendedTimeSlots": []interface{}{}}))
+	require.Eventually(t, func() bool {
+		snapshot, found := second.Snapshot("remote")
+		return found && len(snapshot) == 5
+	}, 5*time.Second, 10*time.Millisecond)
+
+	server := httptest.NewServer(setupRouter())
+	defer server.Close()
+	resp, err := http.Get(server.URL + "/v1/events/remote/stream")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	reader := bufio.NewReader(resp.Body)
+
+	// The snapshot is ordered like one taken from the local store
+	name, data := readServerSentEvent(t, reader)
+	assert.Equal(t, "event", name)
+	assert.Contains(t, data, `"title":"Remote meeting"`)
+	for _, expected := range []string{
+		`{"participant_id":"r1","responded":true,"availability":[]}`,
+		`{"participant_id":"r2","responded":false,"availability":[]}`,
+		`{"participant_id":"r3","responded":true,"availability":[]}`,
+	} {
+		name, data = readServerSentEvent(t, reader)
+		assert.Equal(t, "availability", name)
+		assert.JSONEq(t, expected, data)
+	}
+	name, _ = readServerSentEvent(t, reader)
+	assert.Equal(t, "recommendations", name)
+
+	// Deleting the event on the first replica ends the stream and its state
+	first.Publish(newEventUpdate("remote", streamDeleted, map[string]string{"event_id": "remote"}))
+	name, _ = readServerSentEvent(t, reader)
+	assert.Equal(t, "deleted", name)
+	_, found := second.Snapshot("remote")
+	assert.False(t, found)
+}
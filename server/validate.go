@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Decode a JSON request body, rejecting fields the payload does not define.
+// An unknown field is reported as a field error rather than an error, so the
+// handler can answer 422 instead of 400.
+func decodeJSON(r io.Reader, v interface{}) ([]FieldError, error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(v)
+	if err == nil {
+		return nil, nil
+	}
+	// encoding/json has no typed error for unknown fields
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		field = strings.Trim(field, `"`)
+		return []FieldError{{Field: field, Message: "unknown field"}}, nil
+	}
+	return nil, err
+}
+
+// Check an event payload, returning every problem found
+func validateEvent(event Event) []FieldError {
+	var fieldErrors []FieldError
+	if strings.TrimSpace(event.Title) == "" {
+		fieldErrors = append(fieldErrors, FieldError{Field: "title", Message: "title is required"})
+	}
+	if len(event.Slots) == 0 {
+		fieldErrors = append(fieldErrors, FieldError{Field: "slots", Message: "at least one slot is required"})
+	}
+	fieldErrors = append(fieldErrors, validateSlots("slots", event.Slots)...)
+	if event.EstimatedTime <= 0 {
+		fieldErrors = append(fieldErrors, FieldError{Field: "estimatedTime", Message: "estimatedTime must be positive"})
+	} else if len(event.Slots) > 0 && !fitsAnySlot(event.Slots, event.EstimatedTime) {
+		fieldErrors = append(fieldErrors, FieldError{Field: "estimatedTime", Message: "estimatedTime is longer than every slot"})
+	}
+	seen := map[string]bool{}
+	for i, participantID := range event.Participants {
+		field := fmt.Sprintf("participants[%d]", i)
+		if strings.TrimSpace(participantID) == "" {
+			fieldErrors = append(fieldErrors, FieldError{Field: field, Message: "participant must not be empty"})
+		} else if seen[participantID] {
+			fieldErrors = append(fieldErrors, FieldError{Field: field, Message: fmt.Sprintf("duplicate participant %q", participantID)})
+		}
+		seen[participantID] = true
+	}
+	if event.Horizon != nil && len(event.Slots) > 0 {
+		first := event.Slots[0].StartTime
+		for _, slot := range event.Slots[1:] {
+			if slot.StartTime.Before(first) {
+				first = slot.StartTime
+			}
+		}
+		if !event.Horizon.After(first) {
+			fieldErrors = append(fieldErrors, FieldError{Field: "horizon", Message: "horizon must be after the start of the first slot"})
+		} else if event.Horizon.After(first.Add(maxRecurrenceHorizon)) {
+			fieldErrors = append(fieldErrors, FieldError{Field: "horizon", Message: "horizon must be at most two years after the first slot"})
+		}
+	}
+	if err := validateSeries(event.Series); err != nil {
+		fieldErrors = append(fieldErrors, FieldError{Field: "series", Message: err.Error()})
+	}
+	return fieldErrors
+}
+
+// Check an availability payload, returning every problem found
+func validateAvailability(participantID string, eventID string, slots []Slot) []FieldError {
+	var fieldErrors []FieldError
+	if strings.TrimSpace(participantID) == "" {
+		fieldErrors = append(fieldErrors, FieldError{Field: "participant_id", Message: "participant_id is required"})
+	}
+	if eventID == "" {
+		fieldErrors = append(fieldErrors, FieldError{Field: "event_id", Message: "event_id is required"})
+	}
+	return append(fieldErrors, validateSlots("slots", slots)...)
+}
+
+// Check each slot ends after it starts, recurs by a supported rule and has a
+// known preference. Fields are named like slots[0].end_time.
+func validateSlots(field string, slots []Slot) []FieldError {
+	var fieldErrors []FieldError
+	for i, slot := range slots {
+		fieldErrors = append(fieldErrors, validateSlot(fmt.Sprintf("%s[%d].", field, i), slot)...)
+	}
+	return fieldErrors
+}
+
+// Check a single slot, prefixing the names of invalid fields
+func validateSlot(prefix string, slot Slot) []FieldError {
+	var fieldErrors []FieldError
+	if slot.StartTime.IsZero() {
+		fieldErrors = append(fieldErrors, FieldError{Field: prefix + "start_time", Message: "start_time is required"})
+	}
+	if slot.EndTime.IsZero() {
+		fieldErrors = append(fieldErrors, FieldError{Field: prefix + "end_time", Message: "end_time is required"})
+	} else if !slot.EndTime.After(slot.StartTime) {
+		fieldErrors = append(fieldErrors, FieldError{Field: prefix + "end_time", Message: "end_time must be after start_time"})
+	}
+	if err := validateRecurrence([]Slot{slot}); err != nil {
+		name := "rrule"
+		if slot.RRule == "" {
+			name = "exdates"
+		}
+		fieldErrors = append(fieldErrors, FieldError{Field: prefix + name, Message: err.Error()})
+	}
+	if slot.Zone != "" {
+		if _, err := time.LoadLocation(slot.Zone); err != nil {
+			fieldErrors = append(fieldErrors, FieldError{Field: prefix + "zone", Message: fmt.Sprintf("unknown zone %q", slot.Zone)})
+		}
+	}
+	if !containsString(slotPreferences, slot.Preference) {
+		fieldErrors = append(fieldErrors, FieldError{Field: prefix + "preference", Message: "preference must be one of preferred, available or if_needed"})
+	}
+	return fieldErrors
+}
+
+// Helper function to check a meeting of the given length fits in at least one slot
+func fitsAnySlot(slots []Slot, length time.Duration) bool {
+	for _, slot := range slots {
+		if slot.EndTime.Sub(slot.StartTime) >= length {
+			return true
+		}
+	}
+	return false
+}
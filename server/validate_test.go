@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateEventValidation(t *testing.T) {
+	router := setupRouter()
+
+	body := `{
+		"title": " ",
+		"slots": [
+			{"start_time": "2025-01-13T15:00:00Z", "end_time": "2025-01-13T14:00:00Z"},
+			{"start_time": "2025-01-13T16:00:00Z", "end_time": "2025-01-13T16:30:00Z", "rrule": "FREQ=WEEKLY", "zone": "Mars/Olympus"}
+		],
+		"estimatedTime": 3600000000000,
+		"participants": ["a", "b", "a"],
+		"colour": "blue"
+	}`
+	req, err := http.NewRequest("POST", "/event", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, "Expected status code 422")
+	var problem Problem
+	if err := json.NewDecoder(rr.Body).Decode(&problem); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	assert.Equal(t, problemValidation.Type, problem.Type)
+	assert.Equal(t, []FieldError{
+		{Field: "colour", Message: "unknown field"},
+		{Field: "title", Message: "title is required"},
+		{Field: "slots[0].end_time", Message: "end_time must be after start_time"},
+		{Field: "slots[1].zone", Message: `unknown zone "Mars/Olympus"`},
+		{Field: "estimatedTime", Message: "estimatedTime is longer than every slot"},
+		{Field: "participants[2]", Message: `duplicate participant "a"`},
+	}, problem.Errors)
+}
+
+func TestValidateEvent(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	event := Event{
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}},
+		EstimatedTime: time.Hour,
+		Participants:  []string{"a", "b"},
+	}
+	assert.Empty(t, validateEvent(event), "A valid event should have no errors")
+
+	event.EstimatedTime = 0
+	event.Slots = nil
+	assert.Equal(t, []FieldError{
+		{Field: "slots", Message: "at least one slot is required"},
+		{Field: "estimatedTime", Message: "estimatedTime must be positive"},
+	}, validateEvent(event))
+}
+
+func TestUpdateAvailabilityValidation(t *testing.T) {
+	router := setupRouter()
+
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	events["valid"] = Event{ID: "valid", Title: "Test Event", Slots: []Slot{{StartTime: start, EndTime: start.Add(time.Hour)}}, EstimatedTime: time.Hour}
+	body := `{"event_id": "valid", "slots": [{"start_time": "2025-01-13T14:00:00Z", "end_time": "2025-01-13T14:00:00Z", "preference": "maybe"}]}`
+	req, err := http.NewRequest("PUT", "/participant/v1", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, "Expected status code 422")
+	var problem Problem
+	if err := json.NewDecoder(rr.Body).Decode(&problem); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	assert.Equal(t, []FieldError{
+		{Field: "slots[0].end_time", Message: "end_time must be after start_time"},
+		{Field: "slots[0].preference", Message: "preference must be one of preferred, available or if_needed"},
+	}, problem.Errors)
+}
+
+func TestValidateEventHorizon(t *testing.T) {
+	start := time.Date(2025, 1, 13, 14, 0, 0, 0, time.UTC)
+	event := Event{
+		Title:         "Test Event",
+		Slots:         []Slot{{StartTime: start.Add(24 * time.Hour), EndTime: start.Add(25 * time.Hour)}, {StartTime: start, EndTime: start.Add(time.Hour), RRule: "FREQ=WEEKLY"}},
+		EstimatedTime: time.Hour,
+	}
+	horizon := start.AddDate(0, 6, 0)
+	event.Horizon = &horizon
+	assert.Empty(t, validateEvent(event), "A horizon within two years should be accepted")
+
+	before := start.Add(-time.Hour)
+	event.Horizon = &before
+	assert.Equal(t, []FieldError{{Field: "horizon", Message: "horizon must be after the start of the first slot"}}, validateEvent(event))
+
+	farAway := start.AddDate(3, 0, 0)
+	event.Horizon = &farAway
+	assert.Equal(t, []FieldError{{Field: "horizon", Message: "horizon must be at most two years after the first slot"}}, validateEvent(event))
+}